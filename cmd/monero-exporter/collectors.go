@@ -0,0 +1,19 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cirocosta/monero-exporter/pkg/collector"
+)
+
+var collectorsCmd = &cobra.Command{
+	Use:   "collectors",
+	Short: "list the collectors that can be enabled or disabled",
+	Run: func(_ *cobra.Command, _ []string) {
+		for _, name := range collector.Names() {
+			fmt.Println(name)
+		}
+	},
+}
@@ -8,6 +8,8 @@ import (
 func main() {
 	cmd := (&command{}).Cmd()
 	cmd.AddCommand(versionCmd)
+	cmd.AddCommand(collectorsCmd)
+	cmd.AddCommand((&metricsCommand{}).Cmd())
 
 	if err := cmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cirocosta/monero-exporter/pkg/collector"
+)
+
+type metricsCommand struct {
+	collector string
+}
+
+func (c *metricsCommand) Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "metrics",
+		Short: "print the metrics exposed as a markdown table",
+		Args:  cobra.NoArgs,
+		RunE:  c.RunE,
+	}
+
+	cmd.Flags().StringVar(&c.collector, "collector", "",
+		"only print the metrics exposed by this collector "+
+			"('exporter' for those about the exporter itself)")
+
+	return cmd
+}
+
+func (c *metricsCommand) RunE(_ *cobra.Command, _ []string) error {
+	fmt.Println("| name | type | labels | description |")
+	fmt.Println("| ---- | ---- | ------ | ----------- |")
+
+	found := false
+	for _, metric := range collector.Catalog() {
+		if c.collector != "" && metric.Collector != c.collector {
+			continue
+		}
+
+		found = true
+		fmt.Printf("| %s | %s | %s | %s |\n",
+			metric.Name,
+			metric.Type,
+			strings.Join(metric.Labels, ", "),
+			metric.Help,
+		)
+	}
+
+	if !found {
+		return fmt.Errorf("no metrics for collector '%s'", c.collector)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// node is a monero daemon to collect metrics from.
+//
+type node struct {
+	// Name is the value of the `node` label attached to every metric
+	// collected from this node.
+	//
+	Name string `yaml:"name"`
+
+	// Address is the address of the node's RPC server (e.g.,
+	// http://localhost:18081).
+	//
+	Address string `yaml:"address"`
+}
+
+// nodesFile is the format of the file passed via `--nodes-file`, e.g.:
+//
+//	nodes:
+//	  - name: node-1
+//	    address: http://node-1:18081
+//	  - name: node-2
+//	    address: http://node-2:18089
+//
+type nodesFile struct {
+	Nodes []node `yaml:"nodes"`
+}
+
+// parseNode parses a node in the form `[name=]address`, defaulting the name
+// to the address itself if not specified.
+//
+func parseNode(v string) node {
+	parts := strings.SplitN(v, "=", 2)
+	if len(parts) == 1 {
+		return node{Name: v, Address: v}
+	}
+
+	return node{Name: parts[0], Address: parts[1]}
+}
+
+// loadNodesFile reads the list of nodes from a file at `filepath`.
+//
+func loadNodesFile(filepath string) ([]node, error) {
+	content, err := os.ReadFile(filepath)
+	if err != nil {
+		return nil, fmt.Errorf("read file '%s': %w", filepath, err)
+	}
+
+	f := &nodesFile{}
+	if err := yaml.UnmarshalStrict(content, f); err != nil {
+		return nil, fmt.Errorf("unmarshal '%s': %w", filepath, err)
+	}
+
+	return f.Nodes, nil
+}
+
+// validateNodes ensures that every node has an address and a name that is
+// unique across all of them.
+//
+func validateNodes(nodes []node) error {
+	if len(nodes) == 0 {
+		return fmt.Errorf("at least one node must be specified")
+	}
+
+	seen := map[string]struct{}{}
+
+	for _, n := range nodes {
+		if n.Name == "" || n.Address == "" {
+			return fmt.Errorf("node '%s' (%s) must have both a "+
+				"name and an address", n.Name, n.Address)
+		}
+
+		if _, found := seen[n.Name]; found {
+			return fmt.Errorf("duplicate node name '%s'", n.Name)
+		}
+
+		seen[n.Name] = struct{}{}
+	}
+
+	return nil
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/oschwald/geoip2-golang"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/spf13/cobra"
 
 	"github.com/cirocosta/go-monero/pkg/rpc"
@@ -15,10 +18,28 @@ import (
 )
 
 type command struct {
-	telemetryPath string
-	bindAddr      string
-	geoIPFilepath string
-	moneroAddr    string
+	telemetryPath       string
+	bindAddr            string
+	geoIPFilepath       string
+	geoIPASNFilepath    string
+	asnTopN             int
+	blockWindow         uint64
+	incrementalPool     bool
+	moneroAddrs         []string
+	nodesFilepath       string
+	nodeTimeout         time.Duration
+	pollInterval        time.Duration
+	scrapeTimeoutOffset time.Duration
+	collectorTimeouts   map[string]string
+	probePath           string
+	probeTargets        []string
+
+	// collectors and noCollectors map each collector name to whether it
+	// has been explicitly enabled (`--collector.<name>`) or disabled
+	// (`--no-collector.<name>`).
+	//
+	collectors   map[string]*bool
+	noCollectors map[string]*bool
 }
 
 func (c *command) Cmd() *cobra.Command {
@@ -34,30 +55,206 @@ func (c *command) Cmd() *cobra.Command {
 	cmd.Flags().StringVar(&c.telemetryPath, "telemetry-path",
 		"/metrics", "endpoint at which prometheus metrics are served")
 
-	cmd.Flags().StringVar(&c.moneroAddr, "monero-addr",
-		"http://localhost:18081", "address of the monero instance to "+
-			"collect info from")
+	cmd.Flags().StringSliceVar(&c.moneroAddrs, "monero-addr",
+		[]string{"http://localhost:18081"}, "address of a monero "+
+			"instance to collect info from, optionally named "+
+			"(`[name=]address`, can be specified multiple times)")
+
+	cmd.Flags().StringVar(&c.nodesFilepath, "nodes-file",
+		"", "filepath of a yaml file listing the named monero "+
+			"instances to collect info from (in addition to "+
+			"--monero-addr)")
+	_ = cmd.MarkFlagFilename("nodes-file")
+
+	cmd.Flags().DurationVar(&c.nodeTimeout, "node-timeout",
+		time.Minute, "maximum amount of time to spend collecting "+
+			"info from each node")
+
+	cmd.Flags().DurationVar(&c.scrapeTimeoutOffset, "scrape-timeout-offset",
+		500*time.Millisecond, "amount of time to subtract from the "+
+			"scrape timeout advertised by prometheus when deciding "+
+			"for how long to collect")
+
+	cmd.Flags().StringToStringVar(&c.collectorTimeouts, "collector-timeout",
+		nil, "maximum amount of time that a collector has to gather "+
+			"its metrics, overriding --node-timeout "+
+			"(e.g., lastblock=10s,rpc=5s)")
+
+	cmd.Flags().DurationVar(&c.pollInterval, "poll-interval",
+		0, "interval at which to collect info from the nodes in the "+
+			"background, serving scrapes from the last snapshot "+
+			"(0 to collect on every scrape)")
+
+	cmd.Flags().StringVar(&c.probePath, "probe-path",
+		"/probe", "endpoint at which targets allowed via --probe-target "+
+			"can be probed (<path>?target=<monero-addr>)")
+
+	cmd.Flags().StringSliceVar(&c.probeTargets, "probe-target",
+		nil, "address of a monero instance that is allowed to be "+
+			"probed (can be specified multiple times)")
 
 	cmd.Flags().StringVar(&c.geoIPFilepath, "geoip-filepath",
 		"", "filepath of a geoip database file for ip to country "+
 			"resolution")
 	_ = cmd.MarkFlagFilename("geoip-filepath")
 
+	cmd.Flags().StringVar(&c.geoIPASNFilepath, "geoip-asn-filepath",
+		"", "filepath of a geolite2 asn database file for ip to "+
+			"autonomous system resolution")
+	_ = cmd.MarkFlagFilename("geoip-asn-filepath")
+
+	cmd.Flags().IntVar(&c.asnTopN, "asn-top-n",
+		10, "maximum number of autonomous systems to report "+
+			"individually, aggregating the rest under 'other' "+
+			"(0 for no limit)")
+
+	cmd.Flags().Uint64Var(&c.blockWindow, "block-window",
+		720, "number of blocks (counting from the tip) to aggregate "+
+			"block metrics over")
+
+	cmd.Flags().BoolVar(&c.incrementalPool, "incremental-pool",
+		false, "retrieve only the transactions that entered the pool "+
+			"since the last collection, keeping a cache of those "+
+			"already seen")
+
+	c.collectors = map[string]*bool{}
+	c.noCollectors = map[string]*bool{}
+
+	for _, name := range collector.Names() {
+		c.collectors[name] = cmd.Flags().Bool("collector."+name,
+			true, "enable the "+name+" collector")
+		c.noCollectors[name] = cmd.Flags().Bool("no-collector."+name,
+			false, "disable the "+name+" collector")
+	}
+
 	return cmd
 }
 
-func (c *command) RunE(_ *cobra.Command, _ []string) error {
+// enabledCollectors computes the set of collectors to run based on the
+// `--collector.<name>` and `--no-collector.<name>` flags.
+//
+func (c *command) enabledCollectors() []string {
+	names := []string{}
+
+	for _, name := range collector.Names() {
+		if !*c.collectors[name] || *c.noCollectors[name] {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// nodes gathers the nodes to collect info from, either specified via
+// `--monero-addr` or listed in the file at `--nodes-file`.
+//
+// ps.: the default `--monero-addr` is only taken into account when no nodes
+// file has been provided.
+//
+func (c *command) nodes(cmd *cobra.Command) ([]node, error) {
+	nodes := []node{}
+
+	if c.nodesFilepath == "" || cmd.Flags().Changed("monero-addr") {
+		for _, addr := range c.moneroAddrs {
+			nodes = append(nodes, parseNode(addr))
+		}
+	}
+
+	if c.nodesFilepath != "" {
+		fileNodes, err := loadNodesFile(c.nodesFilepath)
+		if err != nil {
+			return nil, fmt.Errorf("load nodes file: %w", err)
+		}
+
+		nodes = append(nodes, fileNodes...)
+	}
+
+	if err := validateNodes(nodes); err != nil {
+		return nil, fmt.Errorf("validate: %w", err)
+	}
+
+	return nodes, nil
+}
+
+// nodeCollector is the collector that gathers metrics from a node.
+//
+type nodeCollector struct {
+	node      node
+	collector *collector.Collector
+}
+
+// newNodeCollector instantiates a collector that targets the node `n`.
+//
+func newNodeCollector(
+	n node, opts ...collector.Option,
+) (*nodeCollector, error) {
+	rpcClient, err := rpc.NewClient(n.Address)
+	if err != nil {
+		return nil, fmt.Errorf("new client '%s': %w", n.Address, err)
+	}
+
+	c, err := collector.New(daemon.NewClient(rpcClient), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("new collector: %w", err)
+	}
+
+	return &nodeCollector{node: n, collector: c}, nil
+}
+
+// nodesGatherer provides, for each scrape, a gatherer with the collectors of
+// all nodes registered to it, having every metric labelled with the name of
+// the node it came from, and the collection bound to the scrape's context.
+//
+// ps.: prometheus gathers from each registered collector concurrently, thus
+// each node is collected from concurrently, bound by its own timeout.
+//
+func nodesGatherer(nodeCollectors []*nodeCollector) exporter.GathererFunc {
+	return func(ctx context.Context) (prometheus.Gatherer, error) {
+		registry := prometheus.NewRegistry()
+
+		for _, nc := range nodeCollectors {
+			err := prometheus.WrapRegistererWith(
+				prometheus.Labels{"node": nc.node.Name},
+				registry,
+			).Register(nc.collector.WithContext(ctx))
+			if err != nil {
+				return nil, fmt.Errorf("register node '%s': %w",
+					nc.node.Name, err)
+			}
+		}
+
+		return registry, nil
+	}
+}
+
+func (c *command) RunE(cmd *cobra.Command, _ []string) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	rpcClient, err := rpc.NewClient(c.moneroAddr)
+	nodes, err := c.nodes(cmd)
 	if err != nil {
-		return fmt.Errorf("new client '%s': %w", c.moneroAddr, err)
+		return fmt.Errorf("nodes: %w", err)
 	}
 
-	daemonClient := daemon.NewClient(rpcClient)
+	collectorOpts := []collector.Option{
+		collector.WithCollectors(c.enabledCollectors()...),
+		collector.WithTimeout(c.nodeTimeout),
+		collector.WithBlockWindowSize(c.blockWindow),
+		collector.WithIncrementalPool(c.incrementalPool),
+	}
 
-	collectorOpts := []collector.Option{}
+	for name, v := range c.collectorTimeouts {
+		timeout, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("collector '%s' timeout: %w", name, err)
+		}
+
+		collectorOpts = append(collectorOpts,
+			collector.WithCollectorTimeout(name, timeout),
+		)
+	}
 
 	if c.geoIPFilepath != "" {
 		db, err := geoip2.Open(c.geoIPFilepath)
@@ -82,14 +279,69 @@ func (c *command) RunE(_ *cobra.Command, _ []string) error {
 		)
 	}
 
-	err = collector.Register(daemonClient, collectorOpts...)
-	if err != nil {
-		return fmt.Errorf("collector register: %w", err)
+	if c.geoIPASNFilepath != "" {
+		db, err := geoip2.Open(c.geoIPASNFilepath)
+		if err != nil {
+			return fmt.Errorf("geoip asn open: %w", err)
+		}
+		defer db.Close()
+
+		asnMapper := func(ip net.IP) (collector.ASN, error) {
+			res, err := db.ASN(ip)
+			if err != nil {
+				return collector.ASN{}, fmt.Errorf(
+					"asn '%s': %w", ip, err,
+				)
+			}
+
+			return collector.ASN{
+				Number:       res.AutonomousSystemNumber,
+				Organization: res.AutonomousSystemOrganization,
+			}, nil
+		}
+
+		collectorOpts = append(collectorOpts,
+			collector.WithASNMapper(asnMapper),
+			collector.WithASNTopN(c.asnTopN),
+		)
 	}
 
+	nodeCollectorOpts := append([]collector.Option{
+		collector.WithPollInterval(c.pollInterval),
+	}, collectorOpts...)
+
+	nodeCollectors := make([]*nodeCollector, len(nodes))
+
+	for idx, n := range nodes {
+		nc, err := newNodeCollector(n, nodeCollectorOpts...)
+		if err != nil {
+			return fmt.Errorf("node '%s' collector: %w", n.Name, err)
+		}
+
+		if c.pollInterval > 0 {
+			go func() { _ = nc.collector.Run(ctx) }()
+		}
+
+		nodeCollectors[idx] = nc
+	}
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(
+			collectors.ProcessCollectorOpts{},
+		),
+	)
+
 	prometheusExporter, err := exporter.New(
 		exporter.WithBindAddress(c.bindAddr),
 		exporter.WithTelemetryPath(c.telemetryPath),
+		exporter.WithProbePath(c.probePath),
+		exporter.WithProbeTargets(c.probeTargets...),
+		exporter.WithCollectorOptions(collectorOpts...),
+		exporter.WithRegistry(registry),
+		exporter.WithGathererFunc(nodesGatherer(nodeCollectors)),
+		exporter.WithScrapeTimeoutOffset(c.scrapeTimeoutOffset),
 	)
 	if err != nil {
 		return fmt.Errorf("new exporter: %w", err)
@@ -0,0 +1,67 @@
+package collector
+
+import (
+	"sort"
+	"strconv"
+)
+
+// unknownASN is the autonomous system used whenever an address could not be
+// mapped to one.
+//
+var unknownASN = ASN{Organization: "unknown"}
+
+// otherASN is the autonomous system under which those that didn't make it to
+// the top-N are aggregated.
+//
+var otherASN = ASN{Organization: "other"}
+
+// labels returns the `asn` and `as_org` label values for this autonomous
+// system.
+//
+func (a ASN) labels() (string, string) {
+	switch a {
+	case unknownASN:
+		return "unknown", a.Organization
+	case otherASN:
+		return "other", a.Organization
+	}
+
+	return strconv.FormatUint(uint64(a.Number), 10), a.Organization
+}
+
+// topASNs keeps the `n` autonomous systems with the highest counts,
+// aggregating all of the others under `other` so that the number of label
+// values stays bounded.
+//
+// ps.: `n == 0` means no limit.
+//
+func topASNs(counters map[ASN]float64, n int) map[ASN]float64 {
+	if n <= 0 || len(counters) <= n {
+		return counters
+	}
+
+	asns := make([]ASN, 0, len(counters))
+	for asn := range counters {
+		asns = append(asns, asn)
+	}
+
+	sort.Slice(asns, func(i, j int) bool {
+		if counters[asns[i]] != counters[asns[j]] {
+			return counters[asns[i]] > counters[asns[j]]
+		}
+
+		return asns[i].Number < asns[j].Number
+	})
+
+	res := make(map[ASN]float64, n+1)
+	for idx, asn := range asns {
+		if idx < n {
+			res[asn] = counters[asn]
+			continue
+		}
+
+		res[otherASN] += counters[asn]
+	}
+
+	return res
+}
@@ -0,0 +1,18 @@
+package collector
+
+// backlogEntry is a transaction waiting in the pool to be mined.
+//
+// ps.: the backlog is built from the transactions in the pool rather than
+// through `get_txpool_backlog`, as monerod serializes the latter as a binary
+// blob holding raw (unescaped) control bytes, which isn't valid JSON.
+//
+type backlogEntry struct {
+	weight uint64
+	fee    uint64
+}
+
+// FeePerByte is the fee paid per byte of weight.
+//
+func (e backlogEntry) FeePerByte() float64 {
+	return feePerByte(e.fee, e.weight, 0)
+}
@@ -0,0 +1,227 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/cirocosta/go-monero/pkg/rpc/daemon"
+)
+
+// defaultBlockWindowSize is the default number of blocks (counting from the
+// tip) kept in the block window - 720 blocks being roughly a day's worth.
+//
+const defaultBlockWindowSize = 720
+
+// blockWindowFeeConcurrency is the maximum number of concurrent requests made
+// to monerod to figure out the fees of the blocks entering the window.
+//
+const blockWindowFeeConcurrency = 8
+
+// windowBlock is a block in the window, along with the fees paid by the
+// transactions included in it (in atomic units).
+//
+type windowBlock struct {
+	header daemon.BlockHeader
+	fees   uint64
+}
+
+// blockWindow keeps a rolling window of the last blocks of the chain so that
+// collectors can look at aggregates over many blocks rather than the tip
+// alone.
+//
+// Headers and fees are cached across collections separately, with only
+// those for blocks that entered the window since the last update being
+// fetched from monerod. As fees can only be looked up on unrestricted RPC
+// ports (`get_coinbase_tx_sum`) and take a request per block, those that
+// only need headers don't depend on them, and whatever fees were fetched by
+// an update that failed midway are kept for the next one.
+//
+type blockWindow struct {
+	size uint64
+
+	mu      sync.Mutex
+	headers []daemon.BlockHeader
+
+	feesMu sync.Mutex
+	fees   map[string]uint64
+}
+
+func newBlockWindow(size uint64) *blockWindow {
+	return &blockWindow{
+		size: size,
+		fees: map[string]uint64{},
+	}
+}
+
+// Headers brings the headers in the window up to date with the tip of the
+// chain, returning a snapshot of them (ordered by height, ascending).
+//
+// ps.: the window is shared by collectors that run concurrently, thus,
+// updates are serialized - given that requests are shared within a
+// collection, waiting on an ongoing update costs no extra requests.
+//
+func (w *blockWindow) Headers(
+	ctx context.Context, client Client,
+) ([]daemon.BlockHeader, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	lastBlockHeaderResp, err := client.GetLastBlockHeader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get last block header: %w", err)
+	}
+
+	tip := lastBlockHeaderResp.BlockHeader
+
+	start := uint64(0)
+	if tip.Height+1 > w.size {
+		start = tip.Height + 1 - w.size
+	}
+
+	cached := w.cachedFrom(start)
+
+	from := start
+	if len(cached) > 0 {
+		from = cached[len(cached)-1].Height + 1
+	}
+
+	headers := []daemon.BlockHeader{}
+	if from <= tip.Height {
+		resp, err := client.GetBlockHeadersRange(ctx, from, tip.Height)
+		if err != nil {
+			return nil, fmt.Errorf("get block headers range: %w", err)
+		}
+
+		headers = resp.Headers
+	}
+
+	// the chain we had cached has been reorganized (or the tip has gone
+	// backwards), so get rid of it altogether - fees for blocks that are
+	// still part of the chain are kept though (see `Fees`).
+	//
+	if !extendsChain(cached, headers, tip) {
+		resp, err := client.GetBlockHeadersRange(ctx, start, tip.Height)
+		if err != nil {
+			return nil, fmt.Errorf("get block headers range: %w", err)
+		}
+
+		headers = resp.Headers
+		cached = nil
+	}
+
+	w.headers = append(append([]daemon.BlockHeader{}, cached...),
+		headers...)
+
+	snapshot := make([]daemon.BlockHeader, len(w.headers))
+	copy(snapshot, w.headers)
+
+	return snapshot, nil
+}
+
+// cachedFrom retrieves the cached headers from height `start` onwards.
+//
+func (w *blockWindow) cachedFrom(start uint64) []daemon.BlockHeader {
+	for idx, header := range w.headers {
+		if header.Height >= start {
+			return w.headers[idx:]
+		}
+	}
+
+	return nil
+}
+
+// Fees pairs each header (as retrieved by `Headers`) with the fees paid in
+// its block, looking up only those not known yet.
+//
+// ps.: fees looked up before one of the lookups fails (or `ctx` is done)
+// are kept, so that the next call picks up from where this one left.
+//
+func (w *blockWindow) Fees(
+	ctx context.Context, client Client, headers []daemon.BlockHeader,
+) ([]windowBlock, error) {
+	w.feesMu.Lock()
+	defer w.feesMu.Unlock()
+
+	blocks := make([]windowBlock, len(headers))
+	fetched := make([]bool, len(headers))
+	sem := make(chan struct{}, blockWindowFeeConcurrency)
+
+	g, gctx := errgroup.WithContext(ctx)
+	for idx, header := range headers {
+		idx, header := idx, header
+
+		blocks[idx].header = header
+		if fees, found := w.fees[header.Hash]; found {
+			blocks[idx].fees = fees
+			continue
+		}
+
+		if header.NumTxes == 0 {
+			continue
+		}
+
+		g.Go(func() error {
+			select {
+			case sem <- struct{}{}:
+			case <-gctx.Done():
+				return gctx.Err()
+			}
+			defer func() { <-sem }()
+
+			resp, err := client.GetCoinbaseTxSum(gctx, header.Height, 1)
+			if err != nil {
+				return fmt.Errorf("get coinbase tx sum %d: %w",
+					header.Height, err)
+			}
+
+			blocks[idx].fees = uint64(resp.FeeAmount)
+			fetched[idx] = true
+
+			return nil
+		})
+	}
+
+	err := g.Wait()
+
+	known := make(map[string]uint64, len(headers))
+	for idx, block := range blocks {
+		if fees, found := w.fees[block.header.Hash]; found {
+			known[block.header.Hash] = fees
+		}
+
+		if fetched[idx] {
+			known[block.header.Hash] = block.fees
+		}
+	}
+
+	w.fees = known
+
+	if err != nil {
+		return nil, err
+	}
+
+	return blocks, nil
+}
+
+// extendsChain tells whether `headers` (fetched to bring `cached` up to date
+// with `tip`) build on top of the blocks cached.
+//
+func extendsChain(
+	cached, headers []daemon.BlockHeader, tip daemon.BlockHeader,
+) bool {
+	if len(cached) == 0 {
+		return true
+	}
+
+	last := cached[len(cached)-1]
+
+	if len(headers) == 0 {
+		return last.Hash == tip.Hash
+	}
+
+	return headers[0].PrevHash == last.Hash &&
+		headers[len(headers)-1].Hash == tip.Hash
+}
@@ -0,0 +1,95 @@
+package collector_test
+
+import (
+	"testing"
+
+	"github.com/cirocosta/monero-exporter/pkg/collector"
+)
+
+// TestBlockWindowIncremental checks that blocks already in the window aren't
+// fetched again by later collections.
+//
+func TestBlockWindowIncremental(t *testing.T) {
+	server := newTestServer(t)
+	c := newServerCollector(t, server,
+		collector.WithCollectors("blockwindow"),
+	)
+
+	gather(t, c)
+
+	headers := server.Requests("get_block_headers_range")
+	fees := server.Requests("get_coinbase_tx_sum")
+
+	if fees != 9 {
+		t.Errorf("expected the fees of the 9 blocks with "+
+			"transactions to be looked up, got %d", fees)
+	}
+
+	gather(t, c)
+
+	if n := server.Requests("get_block_headers_range"); n != headers {
+		t.Errorf("expected headers not to be fetched again, "+
+			"got %d more requests", n-headers)
+	}
+
+	if n := server.Requests("get_coinbase_tx_sum"); n != fees {
+		t.Errorf("expected fees not to be looked up again, "+
+			"got %d more requests", n-fees)
+	}
+}
+
+// TestBlockWindowWithoutFees checks that, with fees not being available
+// (e.g., on restricted RPC ports), only the metrics about fees go missing,
+// with the headers fetched being kept for when they are.
+//
+func TestBlockWindowWithoutFees(t *testing.T) {
+	server := newTestServer(t)
+	server.RemoveResponse("get_coinbase_tx_sum")
+
+	c := newServerCollector(t, server,
+		collector.WithCollectors("blockwindow"),
+	)
+
+	families := gather(t, c)
+
+	if v, found := sample(families, "monero_blockwindow_blocks"); v != 12 {
+		t.Errorf("expected 12 blocks in the window, got %v (found: %t)",
+			v, found)
+	}
+
+	for _, name := range []string{
+		"monero_blockwindow_fees_monero",
+		"monero_blockwindow_fees_piconero_per_byte",
+	} {
+		if _, found := sample(families, name); found {
+			t.Errorf("expected %s not to be reported", name)
+		}
+	}
+
+	success, _ := sample(families, "monero_exporter_collector_success",
+		"collector", "blockwindow")
+	if success != 0 {
+		t.Errorf("expected the collector to be reported as failed")
+	}
+
+	headers := server.Requests("get_block_headers_range")
+
+	err := server.SetResponse("get_coinbase_tx_sum", map[string]interface{}{
+		"status":     "OK",
+		"fee_amount": 120000000,
+	})
+	if err != nil {
+		t.Fatalf("set response: %v", err)
+	}
+
+	families = gather(t, c)
+
+	if _, found := sample(families, "monero_blockwindow_fees_monero"); !found {
+		t.Errorf("expected fees to be reported once available")
+	}
+
+	if n := server.Requests("get_block_headers_range"); n != headers {
+		t.Errorf("expected headers not to be fetched again, "+
+			"got %d more requests", n-headers)
+	}
+}
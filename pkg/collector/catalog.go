@@ -0,0 +1,725 @@
+package collector
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// MetricType is the type of a metric as presented in the exposition.
+//
+type MetricType string
+
+const (
+	MetricTypeGauge     MetricType = "gauge"
+	MetricTypeCounter   MetricType = "counter"
+	MetricTypeSummary   MetricType = "summary"
+	MetricTypeHistogram MetricType = "histogram"
+)
+
+// exporterMetric is what metrics exposed by the exporter itself (rather than
+// by any of the custom collectors) have as their collector.
+//
+const exporterMetric = "exporter"
+
+// Metric describes a single metric exposed by this exporter.
+//
+type Metric struct {
+	// Collector is the name of the custom collector that exposes the
+	// metric, or `exporter` for those exposed by the exporter itself
+	// regardless of the collectors enabled.
+	//
+	Collector string
+
+	Name   string
+	Type   MetricType
+	Help   string
+	Labels []string
+
+	desc *prometheus.Desc
+}
+
+// newMetric instantiates the description of a metric exposed by `collector`.
+//
+func newMetric(
+	collector, name string, ttype MetricType, help string, labels ...string,
+) *Metric {
+	return &Metric{
+		Collector: collector,
+		Name:      name,
+		Type:      ttype,
+		Help:      help,
+		Labels:    labels,
+		desc:      prometheus.NewDesc(name, help, labels, nil),
+	}
+}
+
+// Desc retrieves the prometheus description of the metric.
+//
+func (m *Metric) Desc() *prometheus.Desc {
+	return m.desc
+}
+
+var (
+	// collector: lastblock
+	//
+	metricLastblockSizeBytes = newMetric("lastblock",
+		"monero_lastblock_size_bytes", MetricTypeGauge,
+		"total size of the last block",
+	)
+	metricLastblockDifficulty = newMetric("lastblock",
+		"monero_lastblock_difficulty", MetricTypeGauge,
+		"difficulty used for the last block",
+	)
+	metricLastblockFeesMonero = newMetric("lastblock",
+		"monero_lastblock_fees_monero", MetricTypeGauge,
+		"total amount of fees included in this block",
+	)
+	metricLastblockHeight = newMetric("lastblock",
+		"monero_lastblock_height", MetricTypeGauge,
+		"height of the last block",
+	)
+	metricLastblockRewardMonero = newMetric("lastblock",
+		"monero_lastblock_reward_monero", MetricTypeGauge,
+		"total amount of rewards granted in the last block "+
+			"(subsidy + fees)",
+	)
+	metricLastblockSubsidyMonero = newMetric("lastblock",
+		"monero_lastblock_subsidy_monero", MetricTypeGauge,
+		"newly minted monero for this block",
+	)
+	metricLastblockTransactions = newMetric("lastblock",
+		"monero_lastblock_transactions", MetricTypeGauge,
+		"number of transactions seen in the last block",
+	)
+	metricLastblockFeesMicroneroPerKB = newMetric("lastblock",
+		"monero_lastblock_fees_micronero_per_kb", MetricTypeSummary,
+		"distribution of the feeperkb utilized for txns",
+	)
+	metricLastblockTransactionsSizeBytes = newMetric("lastblock",
+		"monero_lastblock_transactions_size_bytes", MetricTypeSummary,
+		"distribution of the size of the transactions included",
+	)
+	metricLastblockTransactionsInputs = newMetric("lastblock",
+		"monero_lastblock_transactions_inputs", MetricTypeSummary,
+		"distribution of inputs in the last block",
+	)
+	metricLastblockTransactionsOutputs = newMetric("lastblock",
+		"monero_lastblock_transactions_outputs", MetricTypeSummary,
+		"distribution of outputs in the last block",
+	)
+	metricLastblockVersionMajor = newMetric("lastblock",
+		"monero_lastblock_version_major", MetricTypeGauge,
+		"major version of the block format",
+	)
+	metricLastblockVersionMinor = newMetric("lastblock",
+		"monero_lastblock_version_minor", MetricTypeGauge,
+		"minor version of the block format",
+	)
+
+	// collector: blockwindow
+	//
+	metricBlockwindowBlocks = newMetric("blockwindow",
+		"monero_blockwindow_blocks", MetricTypeGauge,
+		"number of blocks in the window",
+	)
+	metricBlockwindowSizeBytes = newMetric("blockwindow",
+		"monero_blockwindow_size_bytes", MetricTypeSummary,
+		"distribution of the size of the blocks in the window",
+	)
+	metricBlockwindowTransactions = newMetric("blockwindow",
+		"monero_blockwindow_transactions", MetricTypeSummary,
+		"distribution of the number of transactions per block "+
+			"in the window",
+	)
+	metricBlockwindowFeesMonero = newMetric("blockwindow",
+		"monero_blockwindow_fees_monero", MetricTypeSummary,
+		"distribution of the fees paid per block in the window",
+	)
+	metricBlockwindowFeesPiconeroPerByte = newMetric("blockwindow",
+		"monero_blockwindow_fees_piconero_per_byte", MetricTypeSummary,
+		"distribution of the fees paid per byte of block for "+
+			"blocks in the window with transactions",
+	)
+	metricBlockwindowIntervalSeconds = newMetric("blockwindow",
+		"monero_blockwindow_interval_seconds", MetricTypeSummary,
+		"distribution of the time between consecutive blocks "+
+			"in the window",
+	)
+
+	// collector: reorgs
+	//
+	metricChainReorgsTotal = newMetric("reorgs",
+		"monero_chain_reorgs_total", MetricTypeCounter,
+		"number of chain reorganizations seen",
+	)
+	metricChainReorgDepth = newMetric("reorgs",
+		"monero_chain_reorg_depth", MetricTypeHistogram,
+		"distribution of the number of blocks replaced by chain "+
+			"reorganizations",
+	)
+
+	// collector: alternate_chains
+	//
+	metricAlternateChains = newMetric("alternate_chains",
+		"monero_alternate_chains", MetricTypeGauge,
+		"number of chains alternative to the main one known to "+
+			"the node",
+	)
+	metricAlternateChainLength = newMetric("alternate_chains",
+		"monero_alternate_chain_length", MetricTypeSummary,
+		"distribution of the number of blocks in the alternate "+
+			"chains since they diverged from the main one",
+	)
+	metricAlternateChainDifficulty = newMetric("alternate_chains",
+		"monero_alternate_chain_difficulty", MetricTypeSummary,
+		"distribution of the cumulative difficulty of the "+
+			"alternate chains",
+	)
+	metricAlternateChainDepth = newMetric("alternate_chains",
+		"monero_alternate_chain_depth", MetricTypeSummary,
+		"distribution of the number of blocks below the tip of the "+
+			"main chain that the alternate chains branch off",
+	)
+	metricAlternateChainMinDepth = newMetric("alternate_chains",
+		"monero_alternate_chain_min_depth", MetricTypeGauge,
+		"number of blocks below the tip of the main chain that the "+
+			"alternate chain closest to it branches off",
+	)
+
+	// collector: hardfork
+	//
+	metricHardforkVersion = newMetric("hardfork",
+		"monero_hardfork_version", MetricTypeGauge,
+		"current hard fork version (major block version)",
+	)
+	metricHardforkVotingVersion = newMetric("hardfork",
+		"monero_hardfork_voting_version", MetricTypeGauge,
+		"hard fork version that this node is voting for",
+	)
+	metricHardforkEnabled = newMetric("hardfork",
+		"monero_hardfork_enabled", MetricTypeGauge,
+		"whether the current hard fork version is enforced",
+	)
+	metricHardforkState = newMetric("hardfork",
+		"monero_hardfork_state", MetricTypeGauge,
+		"state of the hard fork (0: likely forked, 1: update "+
+			"needed, 2: ready)",
+	)
+	metricHardforkEarliestHeight = newMetric("hardfork",
+		"monero_hardfork_earliest_height", MetricTypeGauge,
+		"earliest height at which the current hard fork version "+
+			"is allowed",
+	)
+	metricHardforkWindowBlocks = newMetric("hardfork",
+		"monero_hardfork_window_blocks", MetricTypeGauge,
+		"number of blocks in the hard fork voting window",
+	)
+	metricHardforkVotes = newMetric("hardfork",
+		"monero_hardfork_votes", MetricTypeGauge,
+		"number of votes for the current hard fork version",
+	)
+	metricHardforkThreshold = newMetric("hardfork",
+		"monero_hardfork_threshold", MetricTypeGauge,
+		"number of votes required to enable the current hard fork "+
+			"version",
+	)
+	metricHardforkBlockVotes = newMetric("hardfork",
+		"monero_hardfork_block_votes", MetricTypeGauge,
+		"number of blocks in the block window signalling each "+
+			"version (minor block version)",
+		"version",
+	)
+
+	// collector: network
+	//
+	metricNetworkBlockTargetSeconds = newMetric("network",
+		"monero_network_block_target_seconds", MetricTypeGauge,
+		"expected time between consecutive blocks",
+	)
+	metricNetworkTipAgeSeconds = newMetric("network",
+		"monero_network_tip_age_seconds", MetricTypeGauge,
+		"time since the last block was mined (according to its "+
+			"timestamp)",
+	)
+	metricNetworkHashrate = newMetric("network",
+		"monero_network_hashrate", MetricTypeGauge,
+		"hashes per second estimated from the difficulty of the "+
+			"last block",
+	)
+	metricNetworkHashrateWindow = newMetric("network",
+		"monero_network_hashrate_window", MetricTypeGauge,
+		"hashes per second estimated from the work put into the "+
+			"last blocks",
+		"blocks",
+	)
+	metricNetworkBlockIntervalSeconds = newMetric("network",
+		"monero_network_block_interval_seconds", MetricTypeGauge,
+		"average time between consecutive blocks over the last "+
+			"blocks",
+		"blocks",
+	)
+
+	// collector: emission
+	//
+	metricEmissionHeight = newMetric("emission",
+		"monero_emission_height", MetricTypeGauge,
+		"number of blocks accounted for in the emission totals",
+	)
+	metricEmissionMonero = newMetric("emission",
+		"monero_emission_monero", MetricTypeGauge,
+		"total amount of coins emitted (i.e., the supply)",
+	)
+	metricEmissionFeesMonero = newMetric("emission",
+		"monero_emission_fees_monero", MetricTypeGauge,
+		"total amount of fees paid to miners",
+	)
+	metricEmissionSubsidyMonero = newMetric("emission",
+		"monero_emission_subsidy_monero", MetricTypeGauge,
+		"average amount of newly minted coins per block over the "+
+			"block window",
+	)
+	metricEmissionInflationRatio = newMetric("emission",
+		"monero_emission_inflation_ratio", MetricTypeGauge,
+		"yearly inflation rate given the average subsidy over the "+
+			"block window",
+	)
+
+	// collector: fee_estimate
+	//
+	metricFeeEstimatePiconeroPerByte = newMetric("fee_estimate",
+		"monero_fee_estimate_piconero_per_byte", MetricTypeGauge,
+		"fee per byte recommended by the node for each priority tier",
+		"tier",
+	)
+	metricFeeEstimateQuantizationMaskPiconero = newMetric("fee_estimate",
+		"monero_fee_estimate_quantization_mask_piconero",
+		MetricTypeGauge,
+		"amount that fees are rounded up to a multiple of",
+	)
+	metricFeeEstimateTransactionPoolTransactions = newMetric(
+		"fee_estimate",
+		"monero_fee_estimate_transaction_pool_transactions",
+		MetricTypeGauge,
+		"number of transactions in the pool by the highest priority "+
+			"tier whose fee they pay (`none` if below all tiers)",
+		"tier",
+	)
+
+	// collector: transaction_pool
+	//
+	metricTransactionPoolSpentKeyImages = newMetric("transaction_pool",
+		"monero_transaction_pool_spent_key_images", MetricTypeGauge,
+		"total number of key images spent across all "+
+			"transactions in the pool",
+	)
+	metricTransactionPoolTransactions = newMetric("transaction_pool",
+		"monero_transaction_pool_transactions", MetricTypeGauge,
+		"number of transactions in the pool at the moment of "+
+			"the scrape",
+	)
+	metricTransactionPoolUntrackedTransactions = newMetric(
+		"transaction_pool",
+		"monero_transaction_pool_untracked_transactions",
+		MetricTypeGauge,
+		"number of transactions in the pool left out of the "+
+			"per-transaction figures for not fitting in the cache",
+	)
+	metricTransactionPoolSizeBytes = newMetric("transaction_pool",
+		"monero_transaction_pool_size_bytes", MetricTypeGauge,
+		"total size of the transaction pool",
+	)
+	metricTransactionPoolTransactionsSizeBytes = newMetric("transaction_pool",
+		"monero_transaction_pool_transactions_size_bytes", MetricTypeSummary,
+		"distribution of the size of the transactions in the "+
+			"transaction pool",
+	)
+	metricTransactionPoolFeesMicroneroPerKB = newMetric("transaction_pool",
+		"monero_transaction_pool_fees_micronero_per_kb", MetricTypeSummary,
+		"distribution of the feeperkb utilized for txns in the "+
+			"pool",
+	)
+	metricTransactionPoolTransactionsInputs = newMetric("transaction_pool",
+		"monero_transaction_pool_transactions_inputs", MetricTypeSummary,
+		"distribution of inputs in the pool",
+	)
+	metricTransactionPoolTransactionsOutputs = newMetric("transaction_pool",
+		"monero_transaction_pool_transactions_outputs", MetricTypeSummary,
+		"distribution of outputs in the pool",
+	)
+	metricTransactionPoolTransactionsAge = newMetric("transaction_pool",
+		"monero_transaction_pool_transactions_age", MetricTypeSummary,
+		"distribution of for how long transactions have been "+
+			"in the pool",
+	)
+	metricTransactionPoolFeesMonero = newMetric("transaction_pool",
+		"monero_transaction_pool_fees_monero", MetricTypeGauge,
+		"total amount of fee being spent in the transaction "+
+			"pool",
+	)
+	metricTransactionPoolFailingTransactions = newMetric("transaction_pool",
+		"monero_transaction_pool_failing_transactions", MetricTypeGauge,
+		"number of transactions that are marked as failing",
+	)
+	metricTransactionPoolDoubleSpends = newMetric("transaction_pool",
+		"monero_transaction_pool_double_spends", MetricTypeGauge,
+		"transactions doubly spending outputs",
+	)
+	metricTransactionPoolNotRelayed = newMetric("transaction_pool",
+		"monero_transaction_pool_not_relayed", MetricTypeGauge,
+		"number of transactions that have not been relayed",
+	)
+	metricTransactionPoolOlderThan10m = newMetric("transaction_pool",
+		"monero_transaction_pool_older_than_10m", MetricTypeGauge,
+		"number of transactions that are older than 10m",
+	)
+	metricTransactionPoolStateTransactions = newMetric("transaction_pool",
+		"monero_transaction_pool_state_transactions", MetricTypeGauge,
+		"number of transactions in the pool in each state",
+		"state",
+	)
+	metricTransactionPoolStateSizeBytes = newMetric("transaction_pool",
+		"monero_transaction_pool_state_size_bytes", MetricTypeGauge,
+		"total size of the transactions in the pool in each state",
+		"state",
+	)
+	metricTransactionPoolStateFeesMonero = newMetric("transaction_pool",
+		"monero_transaction_pool_state_fees_monero", MetricTypeGauge,
+		"total amount of fees paid by the transactions in the pool "+
+			"in each state",
+		"state",
+	)
+	metricTransactionPoolStateAge = newMetric("transaction_pool",
+		"monero_transaction_pool_state_age", MetricTypeSummary,
+		"distribution of for how long transactions in each state "+
+			"have been in the pool",
+		"state",
+	)
+
+	// collector: transaction_pool_backlog
+	//
+	metricTransactionPoolBacklogWeightBytes = newMetric(
+		"transaction_pool_backlog",
+		"monero_transaction_pool_backlog_weight_bytes", MetricTypeGauge,
+		"total weight of the transactions waiting in the pool",
+	)
+	metricTransactionPoolBacklogBlocks = newMetric(
+		"transaction_pool_backlog",
+		"monero_transaction_pool_backlog_blocks", MetricTypeGauge,
+		"number of blocks (at the median weight) needed to clear the "+
+			"pool",
+	)
+	metricTransactionPoolBacklogClearanceSeconds = newMetric(
+		"transaction_pool_backlog",
+		"monero_transaction_pool_backlog_clearance_seconds",
+		MetricTypeGauge,
+		"expected time to clear the pool given the target time "+
+			"between blocks",
+	)
+	metricTransactionPoolBacklogInclusionBlocks = newMetric(
+		"transaction_pool_backlog",
+		"monero_transaction_pool_backlog_inclusion_blocks",
+		MetricTypeGauge,
+		"expected number of blocks until a transaction paying the "+
+			"tier's fee gets mined",
+		"tier",
+	)
+
+	// collector: inclusion
+	//
+	metricTransactionInclusionDelaySeconds = newMetric("inclusion",
+		"monero_transaction_inclusion_delay_seconds",
+		MetricTypeHistogram,
+		"time that transactions waited in the pool before being "+
+			"mined, by the fee tier they paid for",
+		"tier",
+	)
+	metricTransactionPoolDroppedTotal = newMetric("inclusion",
+		"monero_transaction_pool_dropped_total", MetricTypeCounter,
+		"number of transactions that left the pool without being "+
+			"mined",
+	)
+
+	// collector: rpc
+	//
+	metricRPCHitsTotal = newMetric("rpc",
+		"monero_rpc_hits_total", MetricTypeGauge,
+		"number of hits that a particular rpc method had since "+
+			"startup",
+		"method",
+	)
+	metricRPCSecondsTotal = newMetric("rpc",
+		"monero_rpc_seconds_total", MetricTypeGauge,
+		"amount of time spent service the method since startup",
+		"method",
+	)
+
+	// collector: connections
+	//
+	metricP2PConnectionsAge = newMetric("connections",
+		"monero_p2p_connections_age", MetricTypeSummary,
+		"distribution of age of the connections we have",
+	)
+	metricP2PConnectionsRxRateBps = newMetric("connections",
+		"monero_p2p_connections_rx_rate_bps", MetricTypeSummary,
+		"distribution of data receive rate in bytes/s",
+	)
+	metricP2PConnectionsTxRateBps = newMetric("connections",
+		"monero_p2p_connections_tx_rate_bps", MetricTypeSummary,
+		"distribution of data transmit rate in bytes/s",
+	)
+	metricP2PConnectionsHeight = newMetric("connections",
+		"monero_p2p_connections_height", MetricTypeSummary,
+		"distribution the height of the peers connected "+
+			"to/from us",
+	)
+	metricP2PConnections = newMetric("connections",
+		"monero_p2p_connections", MetricTypeGauge,
+		"number of connections to/from this node",
+		"type", "state", "country",
+	)
+	metricP2PConnectionsASN = newMetric("connections",
+		"monero_p2p_connections_asn", MetricTypeGauge,
+		"number of connections to/from this node per "+
+			"autonomous system",
+		"asn", "as_org",
+	)
+
+	// collector: peerlist
+	//
+	metricPeerlist = newMetric("peerlist",
+		"monero_peerlist", MetricTypeGauge,
+		"number of node entries in the peerlist",
+		"type", "country",
+	)
+	metricPeerlistASN = newMetric("peerlist",
+		"monero_peerlist_asn", MetricTypeGauge,
+		"number of node entries in the peerlist per autonomous "+
+			"system",
+		"type", "asn", "as_org",
+	)
+	metricPeerlistLastseen = newMetric("peerlist",
+		"monero_peerlist_lastseen", MetricTypeSummary,
+		"distribution of when our peers have been seen",
+	)
+
+	// collector: net
+	//
+	metricNetRxBytes = newMetric("net",
+		"monero_net_rx_bytes", MetricTypeGauge,
+		"number of bytes received by this node",
+	)
+	metricNetTxBytes = newMetric("net",
+		"monero_net_tx_bytes", MetricTypeGauge,
+		"number of bytes sent by this node",
+	)
+
+	// collector: overall
+	//
+	metricInfoUptimeSecondsTotal = newMetric("overall",
+		"monero_info_uptime_seconds_total", MetricTypeGauge,
+		"for how long this node has been up",
+	)
+	metricInfoAlternativeBlocks = newMetric("overall",
+		"monero_info_alternative_blocks", MetricTypeGauge,
+		"number of blocks alternative to the longest",
+	)
+	metricInfoOffline = newMetric("overall",
+		"monero_info_offline", MetricTypeGauge,
+		"whether the node is offline",
+	)
+	metricInfoMainnet = newMetric("overall",
+		"monero_info_mainnet", MetricTypeGauge,
+		"whether the node is connected to mainnet",
+	)
+	metricInfoBlockSizeLimitBytes = newMetric("overall",
+		"monero_info_block_size_limit_bytes", MetricTypeGauge,
+		"maximum hard limit of a block",
+	)
+	metricInfoBlockSizeMedianBytes = newMetric("overall",
+		"monero_info_block_size_median_bytes", MetricTypeGauge,
+		"current median size for computing dynamic fees",
+	)
+	metricInfoSynchronized = newMetric("overall",
+		"monero_info_synchronized", MetricTypeGauge,
+		"whether the node's chain is in sync with the network",
+	)
+	metricInfoHeight = newMetric("overall",
+		"monero_info_height", MetricTypeGauge,
+		"current height of the chain",
+	)
+	metricInfoTargetHeight = newMetric("overall",
+		"monero_info_target_height", MetricTypeGauge,
+		"target height to achieve to be considered in sync",
+	)
+	metricInfoRPCConnections = newMetric("overall",
+		"monero_info_rpc_connections", MetricTypeGauge,
+		"number of rpc connections being served by the node",
+	)
+	metricInfoDatabaseSizeBytes = newMetric("overall",
+		"monero_info_database_size_bytes", MetricTypeGauge,
+		"size of the monero database",
+	)
+	metricInfoFreeSpaceBytes = newMetric("overall",
+		"monero_info_free_space_bytes", MetricTypeGauge,
+		"amount of free space in the partition where monero's "+
+			"database is in",
+	)
+
+	// exporter
+	//
+	metricExporterCollectorSuccess = newMetric(exporterMetric,
+		"monero_exporter_collector_success", MetricTypeGauge,
+		"whether the collector succeeded",
+		"collector",
+	)
+	metricExporterCollectorDurationSeconds = newMetric(exporterMetric,
+		"monero_exporter_collector_duration_seconds", MetricTypeGauge,
+		"how long the collector took to run",
+		"collector",
+	)
+	metricExporterCollectorTimeout = newMetric(exporterMetric,
+		"monero_exporter_collector_timeout", MetricTypeGauge,
+		"whether the collector failed to finish in time",
+		"collector",
+	)
+	metricExporterLastRefreshTimestampSeconds = newMetric(exporterMetric,
+		"monero_exporter_last_refresh_timestamp_seconds", MetricTypeGauge,
+		"when the metrics served for the collector were last "+
+			"refreshed",
+		"collector",
+	)
+	metricUp = newMetric(exporterMetric,
+		"monero_up", MetricTypeGauge,
+		"whether monerod answered to at least one of the "+
+			"collectors",
+	)
+	metricExporterCountryResolutionFailuresTotal = newMetric(exporterMetric,
+		"monero_exporter_country_resolution_failures_total", MetricTypeCounter,
+		"number of addresses that could not be mapped to a "+
+			"country",
+	)
+	metricExporterASNResolutionFailuresTotal = newMetric(exporterMetric,
+		"monero_exporter_asn_resolution_failures_total", MetricTypeCounter,
+		"number of addresses that could not be mapped to an "+
+			"autonomous system",
+	)
+)
+
+// catalog is the set of every metric that this exporter exposes, in the order
+// they're presented.
+//
+var catalog = []*Metric{
+	metricLastblockSizeBytes,
+	metricLastblockDifficulty,
+	metricLastblockFeesMonero,
+	metricLastblockHeight,
+	metricLastblockRewardMonero,
+	metricLastblockSubsidyMonero,
+	metricLastblockTransactions,
+	metricLastblockFeesMicroneroPerKB,
+	metricLastblockTransactionsSizeBytes,
+	metricLastblockTransactionsInputs,
+	metricLastblockTransactionsOutputs,
+	metricLastblockVersionMajor,
+	metricLastblockVersionMinor,
+	metricBlockwindowBlocks,
+	metricBlockwindowSizeBytes,
+	metricBlockwindowTransactions,
+	metricBlockwindowFeesMonero,
+	metricBlockwindowFeesPiconeroPerByte,
+	metricBlockwindowIntervalSeconds,
+	metricChainReorgsTotal,
+	metricChainReorgDepth,
+	metricAlternateChains,
+	metricAlternateChainLength,
+	metricAlternateChainDifficulty,
+	metricAlternateChainDepth,
+	metricAlternateChainMinDepth,
+	metricHardforkVersion,
+	metricHardforkVotingVersion,
+	metricHardforkEnabled,
+	metricHardforkState,
+	metricHardforkEarliestHeight,
+	metricHardforkWindowBlocks,
+	metricHardforkVotes,
+	metricHardforkThreshold,
+	metricHardforkBlockVotes,
+	metricNetworkBlockTargetSeconds,
+	metricNetworkTipAgeSeconds,
+	metricNetworkHashrate,
+	metricNetworkHashrateWindow,
+	metricNetworkBlockIntervalSeconds,
+	metricEmissionHeight,
+	metricEmissionMonero,
+	metricEmissionFeesMonero,
+	metricEmissionSubsidyMonero,
+	metricEmissionInflationRatio,
+	metricFeeEstimatePiconeroPerByte,
+	metricFeeEstimateQuantizationMaskPiconero,
+	metricFeeEstimateTransactionPoolTransactions,
+	metricTransactionPoolSpentKeyImages,
+	metricTransactionPoolTransactions,
+	metricTransactionPoolUntrackedTransactions,
+	metricTransactionPoolSizeBytes,
+	metricTransactionPoolTransactionsSizeBytes,
+	metricTransactionPoolFeesMicroneroPerKB,
+	metricTransactionPoolTransactionsInputs,
+	metricTransactionPoolTransactionsOutputs,
+	metricTransactionPoolTransactionsAge,
+	metricTransactionPoolFeesMonero,
+	metricTransactionPoolFailingTransactions,
+	metricTransactionPoolDoubleSpends,
+	metricTransactionPoolNotRelayed,
+	metricTransactionPoolOlderThan10m,
+	metricTransactionPoolStateTransactions,
+	metricTransactionPoolStateSizeBytes,
+	metricTransactionPoolStateFeesMonero,
+	metricTransactionPoolStateAge,
+	metricTransactionPoolBacklogWeightBytes,
+	metricTransactionPoolBacklogBlocks,
+	metricTransactionPoolBacklogClearanceSeconds,
+	metricTransactionPoolBacklogInclusionBlocks,
+	metricTransactionInclusionDelaySeconds,
+	metricTransactionPoolDroppedTotal,
+	metricRPCHitsTotal,
+	metricRPCSecondsTotal,
+	metricP2PConnectionsAge,
+	metricP2PConnectionsRxRateBps,
+	metricP2PConnectionsTxRateBps,
+	metricP2PConnectionsHeight,
+	metricP2PConnections,
+	metricP2PConnectionsASN,
+	metricPeerlist,
+	metricPeerlistASN,
+	metricPeerlistLastseen,
+	metricNetRxBytes,
+	metricNetTxBytes,
+	metricInfoUptimeSecondsTotal,
+	metricInfoAlternativeBlocks,
+	metricInfoOffline,
+	metricInfoMainnet,
+	metricInfoBlockSizeLimitBytes,
+	metricInfoBlockSizeMedianBytes,
+	metricInfoSynchronized,
+	metricInfoHeight,
+	metricInfoTargetHeight,
+	metricInfoRPCConnections,
+	metricInfoDatabaseSizeBytes,
+	metricInfoFreeSpaceBytes,
+	metricExporterCollectorSuccess,
+	metricExporterCollectorDurationSeconds,
+	metricExporterCollectorTimeout,
+	metricExporterLastRefreshTimestampSeconds,
+	metricUp,
+	metricExporterCountryResolutionFailuresTotal,
+	metricExporterASNResolutionFailuresTotal,
+}
+
+// Catalog retrieves the descriptions of every metric that this exporter may
+// expose.
+//
+func Catalog() []Metric {
+	metrics := make([]Metric, len(catalog))
+	for idx, m := range catalog {
+		metrics[idx] = *m
+	}
+
+	return metrics
+}
@@ -0,0 +1,150 @@
+package collector_test
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/cirocosta/monero-exporter/pkg/collector"
+)
+
+// TestCatalog scrapes every collector against the fake monerod, checking
+// that the metric families exposed are exactly those in the catalog, with the
+// same types, help and labels.
+//
+// ps.: `monero_exporter_last_refresh_timestamp_seconds` is only exposed when
+// polling, thus why polling is also gone through.
+//
+func TestCatalog(t *testing.T) {
+	pollOnly := map[string]bool{
+		"monero_exporter_last_refresh_timestamp_seconds": true,
+	}
+
+	t.Run("scrape", func(t *testing.T) {
+		families := gather(t, newTestCollector(t))
+
+		expected := []collector.Metric{}
+		for _, metric := range collector.Catalog() {
+			if !pollOnly[metric.Name] {
+				expected = append(expected, metric)
+			}
+		}
+
+		compareToCatalog(t, expected, families)
+	})
+
+	t.Run("poll", func(t *testing.T) {
+		c := newTestCollector(t,
+			collector.WithPollInterval(time.Minute),
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		go func() {
+			_ = c.Run(ctx)
+		}()
+
+		families := []*dto.MetricFamily{}
+		for deadline := time.Now().Add(10 * time.Second); ; {
+			families = gather(t, c)
+			if hasFamily(families, pollOnly) {
+				break
+			}
+
+			if time.Now().After(deadline) {
+				t.Fatalf("no refresh after polling for 10s")
+			}
+
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		compareToCatalog(t, collector.Catalog(), families)
+	})
+}
+
+func gather(
+	t *testing.T, c prometheus.Collector,
+) []*dto.MetricFamily {
+	t.Helper()
+
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(c)
+
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("gather: %v", err)
+	}
+
+	return families
+}
+
+func hasFamily(families []*dto.MetricFamily, names map[string]bool) bool {
+	for _, family := range families {
+		if names[family.GetName()] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func compareToCatalog(
+	t *testing.T, catalog []collector.Metric, families []*dto.MetricFamily,
+) {
+	t.Helper()
+
+	exposed := map[string]*dto.MetricFamily{}
+	for _, family := range families {
+		exposed[family.GetName()] = family
+	}
+
+	for _, metric := range catalog {
+		family, found := exposed[metric.Name]
+		if !found {
+			t.Errorf("%s: in the catalog but not exposed", metric.Name)
+			continue
+		}
+
+		delete(exposed, metric.Name)
+
+		ttype := strings.ToLower(family.GetType().String())
+		if ttype != string(metric.Type) {
+			t.Errorf("%s: exposed as %s, catalog has %s",
+				metric.Name, ttype, metric.Type)
+		}
+
+		if family.GetHelp() != metric.Help {
+			t.Errorf("%s: exposed with help %q, catalog has %q",
+				metric.Name, family.GetHelp(), metric.Help)
+		}
+
+		expected := append([]string{}, metric.Labels...)
+		sort.Strings(expected)
+
+		for _, m := range family.GetMetric() {
+			labels := []string{}
+			for _, pair := range m.GetLabel() {
+				labels = append(labels, pair.GetName())
+			}
+			sort.Strings(labels)
+
+			if !reflect.DeepEqual(labels, expected) {
+				t.Errorf("%s: exposed with labels %v, "+
+					"catalog has %v",
+					metric.Name, labels, expected)
+				break
+			}
+		}
+	}
+
+	for name := range exposed {
+		t.Errorf("%s: exposed but not in the catalog", name)
+	}
+}
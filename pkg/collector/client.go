@@ -0,0 +1,65 @@
+package collector
+
+import (
+	"context"
+
+	"github.com/cirocosta/go-monero/pkg/rpc/daemon"
+)
+
+// Client is the subset of monero's daemon RPC that the custom collectors rely
+// on, allowing them to be driven by something other than a real node (e.g.,
+// a `*daemon.Client` pointed at `pkg/fakemonerod`).
+//
+// ps.: it embeds `daemon.Requester` so that collectors can reach RPC methods
+// and endpoints not (yet) covered by `daemon.Client` - it's also what every
+// request made while collecting goes through (see `sharedClient`).
+//
+type Client interface {
+	daemon.Requester
+
+	GetAlternateChains(
+		ctx context.Context,
+	) (*daemon.GetAlternateChainsResult, error)
+	GetBlock(
+		ctx context.Context, params daemon.GetBlockRequestParameters,
+	) (*daemon.GetBlockResult, error)
+	GetBlockHeadersRange(
+		ctx context.Context, start, end uint64,
+	) (*daemon.GetBlockHeadersRangeResult, error)
+	GetCoinbaseTxSum(
+		ctx context.Context, height, count uint64,
+	) (*daemon.GetCoinbaseTxSumResult, error)
+	GetConnections(ctx context.Context) (*daemon.GetConnectionsResult, error)
+	GetInfo(ctx context.Context) (*daemon.GetInfoResult, error)
+	GetLastBlockHeader(
+		ctx context.Context,
+	) (*daemon.GetLastBlockHeaderResult, error)
+	GetNetStats(ctx context.Context) (*daemon.GetNetStatsResult, error)
+	GetPeerList(ctx context.Context) (*daemon.GetPeerListResult, error)
+	GetTransactionPool(
+		ctx context.Context,
+	) (*daemon.GetTransactionPoolResult, error)
+	GetTransactionPoolStats(
+		ctx context.Context,
+	) (*daemon.GetTransactionPoolStatsResult, error)
+	GetTransactions(
+		ctx context.Context, txns []string,
+	) (*daemon.GetTransactionsResult, error)
+	HardForkInfo(ctx context.Context) (*daemon.HardForkInfoResult, error)
+	RPCAccessTracking(
+		ctx context.Context,
+	) (*daemon.RPCAccessTrackingResult, error)
+}
+
+var _ Client = (*daemon.Client)(nil)
+
+// sharedClient wraps `client` so that the requests made through it are shared
+// among the collectors for a single run (see `sharedRequester`).
+//
+// ps.: the methods are served by a `daemon.Client` on top of `client`'s own
+// requester, thus, whatever implementation is passed, requests go through
+// its `JSONRPC` and `RawRequest` methods.
+//
+func sharedClient(client Client) Client {
+	return daemon.NewClient(newSharedRequester(client))
+}
@@ -0,0 +1,72 @@
+package collector_test
+
+import (
+	"testing"
+
+	"github.com/cirocosta/go-monero/pkg/rpc"
+	"github.com/cirocosta/go-monero/pkg/rpc/daemon"
+
+	"github.com/cirocosta/monero-exporter/pkg/collector"
+)
+
+// wrappedClient is a collector.Client other than `*daemon.Client`.
+//
+type wrappedClient struct {
+	collector.Client
+}
+
+// TestSharedRequests checks that requests needed by multiple collectors are
+// made only once per collection, whatever the implementation of the client.
+//
+func TestSharedRequests(t *testing.T) {
+	for _, tc := range []struct {
+		desc string
+		wrap func(c *daemon.Client) collector.Client
+	}{
+		{
+			desc: "daemon client",
+			wrap: func(c *daemon.Client) collector.Client {
+				return c
+			},
+		},
+		{
+			desc: "other client",
+			wrap: func(c *daemon.Client) collector.Client {
+				return wrappedClient{c}
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			server := newTestServer(t)
+
+			client, err := rpc.NewClient(server.URL)
+			if err != nil {
+				t.Fatalf("rpc client: %v", err)
+			}
+
+			c, err := collector.New(tc.wrap(daemon.NewClient(client)))
+			if err != nil {
+				t.Fatalf("collector: %v", err)
+			}
+
+			for round := 1; round <= 2; round++ {
+				gather(t, c)
+
+				for _, method := range []string{
+					"get_info",
+					"get_last_block_header",
+					"get_fee_estimate",
+				} {
+					n := server.Requests(method)
+					if n != round {
+						t.Errorf("expected %s to be requested "+
+							"%d time(s), got %d",
+							method, round, n)
+					}
+				}
+			}
+		})
+	}
+}
@@ -2,17 +2,17 @@ package collector
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/zapr"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
-	"golang.org/x/sync/errgroup"
-
-	"github.com/cirocosta/go-monero/pkg/rpc/daemon"
+	"golang.org/x/sync/singleflight"
 )
 
 // CountryMapper defines the signature of a function that given an IP,
@@ -22,14 +22,45 @@ import (
 //
 type CountryMapper func(net.IP) (string, error)
 
+// unknownCountry is the country label value used whenever an address could not
+// be mapped to a country.
+//
+const unknownCountry = "unknown"
+
+// ASN represents an autonomous system, identified by its number and the
+// organization that operates it.
+//
+type ASN struct {
+	Number       uint
+	Organization string
+}
+
+// ASNMapper defines the signature of a function that given an IP, translates
+// it into the autonomous system that announces it.
+//
+//	f(ip) -> {24940, Hetzner Online GmbH}
+//
+type ASNMapper func(net.IP) (ASN, error)
+
+// defaultASNTopN is the default maximum number of autonomous systems to
+// report individually, with the remaining ones being aggregated under
+// `other`.
+//
+const defaultASNTopN = 10
+
+// defaultTimeout is the default maximum amount of time that each custom
+// collector has to gather its metrics.
+//
+const defaultTimeout = 1 * time.Minute
+
 // Collector implements the prometheus Collector interface, providing monero
 // metrics whenever a prometheus scrape is received.
 //
 type Collector struct {
 	// client is a Go client that communicated with a `monero` daemon via
-	// plain HTTP(S) RPC.
+	// plain HTTP(S) RPC (usually, a `*daemon.Client`).
 	//
-	client *daemon.Client
+	client Client
 
 	// countryMapper is a function that knows how to translate IPs to
 	// country codes.
@@ -38,6 +69,97 @@ type Collector struct {
 	//
 	countryMapper CountryMapper
 
+	// countryResolutionFailures counts the number of addresses that we
+	// failed to map to a country.
+	//
+	countryResolutionFailures prometheus.Counter
+
+	// asnMapper is a function that knows how to translate IPs to the
+	// autonomous systems they belong to.
+	//
+	// optional: if nil, no asn-mapping will take place.
+	//
+	asnMapper ASNMapper
+
+	// asnTopN is the maximum number of autonomous systems to report
+	// individually. A value of 0 means no limit.
+	//
+	asnTopN int
+
+	// asnResolutionFailures counts the number of addresses that we failed
+	// to map to an autonomous system.
+	//
+	asnResolutionFailures prometheus.Counter
+
+	// collectors is the set of names of the custom collectors to run on
+	// every scrape.
+	//
+	collectors []string
+
+	// timeout is the maximum amount of time that each custom collector
+	// has to gather its metrics.
+	//
+	timeout time.Duration
+
+	// collectorTimeouts overrides `timeout` for specific collectors,
+	// keyed by their names.
+	//
+	collectorTimeouts map[string]time.Duration
+
+	// blockWindowSize is the number of blocks (counting from the tip)
+	// that the block window spans.
+	//
+	blockWindowSize uint64
+
+	// blockWindow is the cache of the last blocks of the chain, shared
+	// by the collectors that look at more than the tip.
+	//
+	blockWindow *blockWindow
+
+	// reorgTracker keeps track of the last blocks of the chain across
+	// collections so that reorganizations can be detected.
+	//
+	reorgTracker *reorgTracker
+
+	// emissionTracker keeps the running sums of the coins emitted and
+	// fees paid across collections.
+	//
+	emissionTracker *emissionTracker
+
+	// inclusionTracker remembers when transactions were first seen in
+	// the pool across collections so that the time until they get mined
+	// can be measured.
+	//
+	inclusionTracker *inclusionTracker
+
+	// incrementalPool indicates whether the transaction pool should be
+	// looked at incrementally (see `WithIncrementalPool`).
+	//
+	incrementalPool bool
+
+	// pool is where the collectors that look at the transactions in the
+	// pool retrieve them from.
+	//
+	pool poolSource
+
+	// pollInterval is the interval at which the custom collectors are
+	// run in the background (see `Run`).
+	//
+	// optional: if 0, collectors run synchronously on every scrape.
+	//
+	pollInterval time.Duration
+
+	// results holds the outcome of the last run of each collector when
+	// polling.
+	//
+	results   []collectorResult
+	resultsMu sync.RWMutex
+
+	// inflight ensures that concurrent scrapes share the same
+	// collection.
+	//
+	inflight singleflight.Group
+
 	log logr.Logger
 }
 
@@ -59,34 +181,209 @@ func WithCountryMapper(v CountryMapper) func(c *Collector) {
 	}
 }
 
+// WithASNMapper is a functional argument that enables the breakdown of
+// connections and peers by the autonomous system they belong to.
+//
+func WithASNMapper(v ASNMapper) func(c *Collector) {
+	return func(c *Collector) {
+		c.asnMapper = v
+	}
+}
+
+// WithASNTopN is a functional argument that overrides the default maximum
+// number of autonomous systems reported individually (0 for no limit).
+//
+func WithASNTopN(v int) func(c *Collector) {
+	return func(c *Collector) {
+		c.asnTopN = v
+	}
+}
+
+// WithCollectors is a functional argument that overrides the default set of
+// custom collectors to run (all of them) with those named (see `Names`).
+//
+func WithCollectors(names ...string) func(c *Collector) {
+	return func(c *Collector) {
+		c.collectors = names
+	}
+}
+
+// WithTimeout is a functional argument that overrides the default maximum
+// amount of time that each collector has to gather its metrics.
+//
+func WithTimeout(v time.Duration) func(c *Collector) {
+	return func(c *Collector) {
+		c.timeout = v
+	}
+}
+
+// WithCollectorTimeout is a functional argument that overrides the maximum
+// amount of time that the collector named `name` has to gather its metrics.
+//
+func WithCollectorTimeout(name string, v time.Duration) func(c *Collector) {
+	return func(c *Collector) {
+		c.collectorTimeouts[name] = v
+	}
+}
+
+// WithBlockWindowSize is a functional argument that overrides the default
+// number of blocks (counting from the tip) that the block window spans.
+//
+func WithBlockWindowSize(v uint64) func(c *Collector) {
+	return func(c *Collector) {
+		c.blockWindowSize = v
+	}
+}
+
+// WithIncrementalPool is a functional argument that makes the transactions in
+// the pool be retrieved incrementally: only the hashes of those in the pool
+// are retrieved on every collection, with the transactions themselves only
+// being retrieved once (as they enter the pool) and kept in a bounded cache
+// from there on.
+//
+// ps.: as monerod doesn't tell about some of the properties of transactions
+// when retrieving them that way (e.g., weight, `kept_by_block`), those end up
+// estimated or left unreported.
+//
+func WithIncrementalPool(v bool) func(c *Collector) {
+	return func(c *Collector) {
+		c.incrementalPool = v
+	}
+}
+
 func defaultCountryMapper(_ net.IP) (string, error) {
-	return "unknown", nil
+	return unknownCountry, nil
+}
+
+// resolveCountry wraps the configured country mapper so that any failure to
+// resolve an address lands in the `unknown` bucket, being accounted for in the
+// resolution failures counter.
+//
+func (c *Collector) resolveCountry(ip net.IP) (string, error) {
+	if ip == nil {
+		c.countryResolutionFailures.Inc()
+		return unknownCountry, nil
+	}
+
+	country, err := c.countryMapper(ip)
+	if err != nil || country == "" {
+		c.countryResolutionFailures.Inc()
+		return unknownCountry, nil
+	}
+
+	return country, nil
+}
+
+// resolveASN wraps the configured asn mapper so that any failure to resolve
+// an address lands in the `unknown` bucket, being accounted for in the
+// resolution failures counter.
+//
+// ps.: returns nil if no asn mapper has been configured.
+//
+func (c *Collector) resolveASN() ASNMapper {
+	if c.asnMapper == nil {
+		return nil
+	}
+
+	return func(ip net.IP) (ASN, error) {
+		if ip == nil {
+			c.asnResolutionFailures.Inc()
+			return unknownASN, nil
+		}
+
+		asn, err := c.asnMapper(ip)
+		if err != nil || asn.Number == 0 {
+			c.asnResolutionFailures.Inc()
+			return unknownASN, nil
+		}
+
+		return asn, nil
+	}
 }
 
 // Register registers this collector with the global prometheus collectors
 // registry making it available for an exporter to collect our metrics.
 //
-func Register(client *daemon.Client, opts ...Option) error {
+// ps.: to register it with a registry of your own, use `New` instead.
+//
+func Register(client Client, opts ...Option) error {
+	c, err := New(client, opts...)
+	if err != nil {
+		return fmt.Errorf("new: %w", err)
+	}
+
+	if err := prometheus.Register(c); err != nil {
+		return fmt.Errorf("register: %w", err)
+	}
+
+	return nil
+}
+
+// New instantiates a new collector with defaults, unless options are passed,
+// leaving it up to the caller to register it with a prometheus registry.
+//
+func New(client Client, opts ...Option) (*Collector, error) {
 	defaultLogger, err := zap.NewDevelopment()
 	if err != nil {
-		return fmt.Errorf("zap new development: %w", err)
+		return nil, fmt.Errorf("zap new development: %w", err)
 	}
 
 	c := &Collector{
 		client:        client,
 		countryMapper: defaultCountryMapper,
-		log:           zapr.NewLogger(defaultLogger),
+		countryResolutionFailures: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name: metricExporterCountryResolutionFailuresTotal.Name,
+				Help: metricExporterCountryResolutionFailuresTotal.Help,
+			},
+		),
+		asnTopN: defaultASNTopN,
+		asnResolutionFailures: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name: metricExporterASNResolutionFailuresTotal.Name,
+				Help: metricExporterASNResolutionFailuresTotal.Help,
+			},
+		),
+		collectors:        Names(),
+		timeout:           defaultTimeout,
+		collectorTimeouts: map[string]time.Duration{},
+		blockWindowSize:   defaultBlockWindowSize,
+		log:               zapr.NewLogger(defaultLogger),
 	}
 
 	for _, opt := range opts {
 		opt(c)
 	}
 
-	if err := prometheus.Register(c); err != nil {
-		return fmt.Errorf("register: %w", err)
+	for _, name := range c.collectors {
+		if _, found := lookupCustomCollector(name); !found {
+			return nil, fmt.Errorf("unknown collector '%s'", name)
+		}
 	}
 
-	return nil
+	for name := range c.collectorTimeouts {
+		if _, found := lookupCustomCollector(name); !found {
+			return nil, fmt.Errorf("timeout for unknown "+
+				"collector '%s'", name)
+		}
+	}
+
+	if c.blockWindowSize < 2 {
+		return nil, fmt.Errorf("block window must span at least "+
+			"2 blocks, got %d", c.blockWindowSize)
+	}
+
+	c.blockWindow = newBlockWindow(c.blockWindowSize)
+	c.reorgTracker = newReorgTracker(reorgTrackerDepth, c.log)
+	c.emissionTracker = newEmissionTracker()
+	c.inclusionTracker = newInclusionTracker(inclusionTrackerSize)
+
+	c.pool = fullPool{}
+	if c.incrementalPool {
+		c.pool = newPoolCache(defaultPoolCacheSize)
+	}
+
+	return c, nil
 }
 
 // CollectFunc defines a standardized signature for functions that want to
@@ -94,11 +391,32 @@ func Register(client *daemon.Client, opts ...Option) error {
 //
 type CollectFunc func(ctx context.Context, ch chan<- prometheus.Metric) error
 
-// Describe implements the Describe function of the Collector interface.
+// Describe implements the Describe function of the Collector interface,
+// describing every metric in the catalog that may be exposed given the
+// collectors enabled, making this a "checked" collector.
 //
 func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
-	// Because we can present the description of the metrics at collection
-	// time, we don't need to write anything to the channel.
+	for _, metric := range catalog {
+		if metric.Collector != exporterMetric &&
+			!c.isEnabled(metric.Collector) {
+			continue
+		}
+
+		ch <- metric.Desc()
+	}
+}
+
+// isEnabled tells whether the custom collector that goes by `name` is
+// enabled.
+//
+func (c *Collector) isEnabled(name string) bool {
+	for _, enabled := range c.collectors {
+		if enabled == name {
+			return true
+		}
+	}
+
+	return false
 }
 
 type CustomCollector interface {
@@ -106,41 +424,410 @@ type CustomCollector interface {
 	Collect(ctx context.Context) error
 }
 
+// customCollectorFactory instantiates a CustomCollector that reports its
+// metrics through `ch`.
+//
+type customCollectorFactory func(
+	c *Collector, client Client, ch chan<- prometheus.Metric,
+) CustomCollector
+
+// customCollectors is the set of all custom collectors that can be enabled,
+// keyed by the name they go by.
+//
+var customCollectors = []struct {
+	name string
+	new  customCollectorFactory
+}{
+	{"lastblock", func(
+		c *Collector, client Client, ch chan<- prometheus.Metric,
+	) CustomCollector {
+		return NewLastBlockStatsCollector(client, ch)
+	}},
+	{"blockwindow", func(
+		c *Collector, client Client, ch chan<- prometheus.Metric,
+	) CustomCollector {
+		return NewBlockWindowCollector(client, ch, c.blockWindow)
+	}},
+	{"reorgs", func(
+		c *Collector, client Client, ch chan<- prometheus.Metric,
+	) CustomCollector {
+		return NewReorgsCollector(client, ch, c.reorgTracker)
+	}},
+	{"alternate_chains", func(
+		c *Collector, client Client, ch chan<- prometheus.Metric,
+	) CustomCollector {
+		return NewAlternateChainsCollector(client, ch)
+	}},
+	{"hardfork", func(
+		c *Collector, client Client, ch chan<- prometheus.Metric,
+	) CustomCollector {
+		return NewHardForkCollector(client, ch, c.blockWindow)
+	}},
+	{"network", func(
+		c *Collector, client Client, ch chan<- prometheus.Metric,
+	) CustomCollector {
+		return NewNetworkCollector(client, ch, c.blockWindow)
+	}},
+	{"emission", func(
+		c *Collector, client Client, ch chan<- prometheus.Metric,
+	) CustomCollector {
+		return NewEmissionCollector(client, ch,
+			c.emissionTracker, c.blockWindow)
+	}},
+	{"fee_estimate", func(
+		c *Collector, client Client, ch chan<- prometheus.Metric,
+	) CustomCollector {
+		return NewFeeEstimateCollector(client, ch, c.pool)
+	}},
+	{"transaction_pool", func(
+		c *Collector, client Client, ch chan<- prometheus.Metric,
+	) CustomCollector {
+		return NewTransactionPoolCollector(client, ch, c.pool)
+	}},
+	{"transaction_pool_backlog", func(
+		c *Collector, client Client, ch chan<- prometheus.Metric,
+	) CustomCollector {
+		return NewTransactionPoolBacklogCollector(client, ch, c.pool)
+	}},
+	{"inclusion", func(
+		c *Collector, client Client, ch chan<- prometheus.Metric,
+	) CustomCollector {
+		return NewInclusionCollector(client, ch,
+			c.inclusionTracker, c.pool)
+	}},
+	{"rpc", func(
+		c *Collector, client Client, ch chan<- prometheus.Metric,
+	) CustomCollector {
+		return NewRPCCollector(client, ch)
+	}},
+	{"connections", func(
+		c *Collector, client Client, ch chan<- prometheus.Metric,
+	) CustomCollector {
+		return NewConnectionsCollector(client, ch,
+			c.resolveCountry, c.resolveASN(), c.asnTopN)
+	}},
+	{"peerlist", func(
+		c *Collector, client Client, ch chan<- prometheus.Metric,
+	) CustomCollector {
+		return NewPeersCollector(client, ch,
+			c.resolveCountry, c.resolveASN(), c.asnTopN)
+	}},
+	{"net", func(
+		c *Collector, client Client, ch chan<- prometheus.Metric,
+	) CustomCollector {
+		return NewNetStatsCollector(client, ch)
+	}},
+	{"overall", func(
+		c *Collector, client Client, ch chan<- prometheus.Metric,
+	) CustomCollector {
+		return NewOverallCollector(client, ch)
+	}},
+}
+
+// Names returns the names of all of the custom collectors that can be
+// enabled (see `WithCollectors`).
+//
+func Names() []string {
+	names := make([]string, len(customCollectors))
+	for idx, cc := range customCollectors {
+		names[idx] = cc.name
+	}
+
+	return names
+}
+
+func lookupCustomCollector(name string) (customCollectorFactory, bool) {
+	for _, cc := range customCollectors {
+		if cc.name == name {
+			return cc.new, true
+		}
+	}
+
+	return nil, false
+}
+
 // Collect implements the Collect function of the Collector interface.
 //
 // Here is where all of the calls to a monero rpc endpoint is made, each being
 // wrapped in its own function, all being called concurrently.
 //
+// Each collector runs with its own context, so that the failure of one (e.g.,
+// an endpoint that is not available in restricted mode) does not prevent the
+// others from delivering their metrics.
+//
+// ps.: when polling (see `WithPollInterval`), no calls are made, with the
+// metrics gathered in the last refresh being served instead.
+//
 func (c *Collector) Collect(ch chan<- prometheus.Metric) {
-	var g *errgroup.Group
+	c.CollectContext(context.Background(), ch)
+}
+
+// CollectContext is the same as `Collect`, but with the collection being
+// bound to `ctx`, allowing callers to place a deadline on it (e.g., based on
+// prometheus' scrape timeout).
+//
+// Collectors that don't finish in time are reported as timed out rather than
+// holding the response back.
+//
+func (c *Collector) CollectContext(
+	ctx context.Context, ch chan<- prometheus.Metric,
+) {
+	var results []collectorResult
+
+	if c.pollInterval > 0 {
+		results = c.lastResults()
+	} else {
+		results = c.sharedRunCollectors(ctx)
+	}
+
+	for _, result := range results {
+		for _, metric := range result.metrics {
+			ch <- metric
+		}
+	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	c.collectResults(ch, results)
+	c.countryResolutionFailures.Collect(ch)
+	c.asnResolutionFailures.Collect(ch)
+}
+
+// WithContext provides a prometheus collector whose collection is bound to
+// `ctx` (see `CollectContext`).
+//
+func (c *Collector) WithContext(ctx context.Context) prometheus.Collector {
+	return &contextCollector{
+		Collector: c,
+		ctx:       ctx,
+	}
+}
+
+// contextCollector is a Collector whose collection is bound to a context.
+//
+type contextCollector struct {
+	*Collector
+	ctx context.Context
+}
+
+// Collect implements the Collect function of the Collector interface.
+//
+func (c *contextCollector) Collect(ch chan<- prometheus.Metric) {
+	c.CollectContext(c.ctx, ch)
+}
+
+// sharedRunCollectors runs all of the enabled custom collectors, sharing
+// the results with any other concurrent caller (e.g., multiple prometheus
+// servers scraping at the same time) rather than collecting all over again.
+//
+// ps.: the collection that is shared is bound to the context of the caller
+// that initiated it - callers that joined it only wait for as long as their
+// own context allows, getting every collector reported as timed out
+// otherwise.
+//
+func (c *Collector) sharedRunCollectors(
+	ctx context.Context,
+) []collectorResult {
+	start := time.Now()
+
+	resC := c.inflight.DoChan("collect", func() (interface{}, error) {
+		return c.runCollectors(ctx), nil
+	})
+
+	select {
+	case res := <-resC:
+		results, _ := res.Val.([]collectorResult)
+		return results
+	case <-ctx.Done():
+		return c.timedOutResults(time.Since(start))
+	}
+}
+
+// timedOutResults provides results for all of the enabled custom collectors
+// that report them as having timed out after `duration`.
+//
+func (c *Collector) timedOutResults(
+	duration time.Duration,
+) []collectorResult {
+	results := make([]collectorResult, len(c.collectors))
+	for idx, name := range c.collectors {
+		results[idx] = collectorResult{
+			name:     name,
+			timedOut: true,
+			duration: duration,
+		}
+	}
+
+	return results
+}
+
+// runCollectors runs all of the enabled custom collectors concurrently,
+// gathering the results of each.
+//
+// ps.: requests made by the collectors are shared among them for the
+// duration of this run (e.g., a `get_info` needed by multiple collectors is
+// performed only once).
+//
+func (c *Collector) runCollectors(ctx context.Context) []collectorResult {
+	var wg sync.WaitGroup
+
+	client := sharedClient(c.client)
+	results := make([]collectorResult, len(c.collectors))
+
+	for idx, name := range c.collectors {
+		idx, name := idx, name
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			results[idx] = c.runCollector(ctx, client, name)
+		}()
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+// runCollector runs a single custom collector under a context of its own
+// (derived from `ctx`, bound by the collector's timeout), reporting any
+// failure without affecting the other collectors.
+//
+func (c *Collector) runCollector(
+	ctx context.Context, client Client, name string,
+) collectorResult {
+	ctx, cancel := context.WithTimeout(ctx, c.timeoutFor(name))
 	defer cancel()
 
-	g, ctx = errgroup.WithContext(ctx)
+	metricsC := make(chan prometheus.Metric)
+	metricsDoneC := make(chan []prometheus.Metric, 1)
+	errC := make(chan error, 1)
+
+	go func() {
+		metrics := []prometheus.Metric{}
+		for metric := range metricsC {
+			metrics = append(metrics, metric)
+		}
+
+		metricsDoneC <- metrics
+	}()
+
+	newCollector, _ := lookupCustomCollector(name)
+	start := time.Now()
+
+	go func() {
+		defer close(metricsC)
+
+		errC <- newCollector(c, client, metricsC).Collect(ctx)
+	}()
 
-	for _, collector := range []CustomCollector{
-		NewLastBlockStatsCollector(c.client, ch),
-		NewTransactionPoolCollector(c.client, ch),
-		NewRPCCollector(c.client, ch),
-		NewConnectionsCollector(c.client, ch),
-		NewPeersCollector(c.client, ch),
-		NewNetStatsCollector(c.client, ch),
-		NewOverallCollector(c.client, ch),
-	} {
-		collector := collector
+	result := collectorResult{name: name}
 
-		g.Go(func() error {
-			if err := collector.Collect(ctx); err != nil {
-				return fmt.Errorf("%s collect: %w",
-					collector.Name(), err)
-			}
+	var err error
 
-			return nil
-		})
+	select {
+	case err = <-errC:
+		result.metrics = <-metricsDoneC
+	case <-ctx.Done():
+		// whatever the collector might still report is discarded
+		// (it'll eventually give up as its context is done).
+		//
+		err = fmt.Errorf("ctx err: %w", ctx.Err())
 	}
 
-	if err := g.Wait(); err != nil {
-		c.log.Error(err, "wait")
+	result.duration = time.Since(start)
+	result.timedOut = errors.Is(ctx.Err(), context.DeadlineExceeded)
+	result.success = err == nil
+
+	if err != nil {
+		c.log.Error(err, "collect", "collector", name)
+		return result
+	}
+
+	result.refreshed = start
+
+	return result
+}
+
+// timeoutFor retrieves the maximum amount of time that the collector named
+// `name` has to gather its metrics.
+//
+func (c *Collector) timeoutFor(name string) time.Duration {
+	if timeout, found := c.collectorTimeouts[name]; found {
+		return timeout
 	}
+
+	return c.timeout
+}
+
+// collectorResult holds the outcome of running a custom collector.
+//
+type collectorResult struct {
+	name     string
+	success  bool
+	timedOut bool
+	duration time.Duration
+
+	// metrics is the set of metrics gathered by the collector.
+	//
+	metrics []prometheus.Metric
+
+	// refreshed is when the collector last ran successfully, i.e., when
+	// `metrics` were gathered.
+	//
+	refreshed time.Time
+}
+
+// collectResults reports how each one of the custom collectors performed,
+// as well as whether monerod could be reached at all (i.e., at least one
+// collector succeeded).
+//
+func (c *Collector) collectResults(
+	ch chan<- prometheus.Metric, results []collectorResult,
+) {
+	successDesc := metricExporterCollectorSuccess.Desc()
+	durationDesc := metricExporterCollectorDurationSeconds.Desc()
+	timeoutDesc := metricExporterCollectorTimeout.Desc()
+	lastRefreshDesc := metricExporterLastRefreshTimestampSeconds.Desc()
+
+	up := false
+
+	for _, result := range results {
+		up = up || result.success
+
+		ch <- prometheus.MustNewConstMetric(
+			successDesc,
+			prometheus.GaugeValue,
+			boolToFloat64(result.success),
+			result.name,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			durationDesc,
+			prometheus.GaugeValue,
+			result.duration.Seconds(),
+			result.name,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			timeoutDesc,
+			prometheus.GaugeValue,
+			boolToFloat64(result.timedOut),
+			result.name,
+		)
+
+		if c.pollInterval > 0 && !result.refreshed.IsZero() {
+			ch <- prometheus.MustNewConstMetric(
+				lastRefreshDesc,
+				prometheus.GaugeValue,
+				float64(result.refreshed.Unix()),
+				result.name,
+			)
+		}
+	}
+
+	ch <- prometheus.MustNewConstMetric(
+		metricUp.Desc(),
+		prometheus.GaugeValue,
+		boolToFloat64(up),
+	)
 }
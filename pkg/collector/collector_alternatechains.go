@@ -0,0 +1,128 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cirocosta/go-monero/pkg/rpc/daemon"
+)
+
+type AlternateChainsCollector struct {
+	client   Client
+	metricsC chan<- prometheus.Metric
+
+	chains *daemon.GetAlternateChainsResult
+	height uint64
+}
+
+var _ CustomCollector = (*AlternateChainsCollector)(nil)
+
+func NewAlternateChainsCollector(
+	client Client, metricsC chan<- prometheus.Metric,
+) *AlternateChainsCollector {
+	return &AlternateChainsCollector{
+		client:   client,
+		metricsC: metricsC,
+	}
+}
+
+func (c *AlternateChainsCollector) Name() string {
+	return "alternate_chains"
+}
+
+func (c *AlternateChainsCollector) Collect(ctx context.Context) error {
+	err := c.fetchData(ctx)
+	if err != nil {
+		return fmt.Errorf("fetch data: %w", err)
+	}
+
+	c.collectChainsCount()
+	c.collectChains()
+
+	return nil
+}
+
+func (c *AlternateChainsCollector) fetchData(ctx context.Context) error {
+	info, err := c.client.GetInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("get info: %w", err)
+	}
+
+	chains, err := c.client.GetAlternateChains(ctx)
+	if err != nil {
+		return fmt.Errorf("get alternate chains: %w", err)
+	}
+
+	c.height = info.Height
+	c.chains = chains
+
+	return nil
+}
+
+func (c *AlternateChainsCollector) collectChainsCount() {
+	c.metricsC <- prometheus.MustNewConstMetric(
+		metricAlternateChains.Desc(),
+		prometheus.GaugeValue,
+		float64(len(c.chains.Chains)),
+	)
+}
+
+// collectChains reports the distribution of the length and difficulty of the
+// alternate chains, as well as of how many blocks below the main chain's tip
+// they branch off.
+//
+// ps.: chains aren't reported individually (e.g., by the hash of their tip)
+// as they come and go, which would make for an ever growing number of
+// series.
+//
+func (c *AlternateChainsCollector) collectChains() {
+	lengths, difficulties, depths := NewSummary(), NewSummary(), NewSummary()
+	minDepth := -1.0
+
+	for _, chain := range c.chains.Chains {
+		lengths.Insert(float64(chain.Length))
+		difficulties.Insert(float64(chain.Difficulty))
+
+		// `height` is that of the tip of the alternate chain, thus, it
+		// branches off the main chain at `height - length`, with the
+		// main chain's tip being at `c.height - 1`.
+		//
+		depth := float64(0)
+		if parent := chain.Height - chain.Length; c.height > parent+1 {
+			depth = float64(c.height - 1 - parent)
+		}
+
+		depths.Insert(depth)
+		if minDepth < 0 || depth < minDepth {
+			minDepth = depth
+		}
+	}
+
+	c.metricsC <- prometheus.MustNewConstSummary(
+		metricAlternateChainLength.Desc(),
+		lengths.Count(), lengths.Sum(), lengths.Quantiles(),
+	)
+
+	c.metricsC <- prometheus.MustNewConstSummary(
+		metricAlternateChainDifficulty.Desc(),
+		difficulties.Count(), difficulties.Sum(),
+		difficulties.Quantiles(),
+	)
+
+	c.metricsC <- prometheus.MustNewConstSummary(
+		metricAlternateChainDepth.Desc(),
+		depths.Count(), depths.Sum(), depths.Quantiles(),
+	)
+
+	if minDepth < 0 {
+		return
+	}
+
+	c.metricsC <- prometheus.MustNewConstMetric(
+		metricAlternateChainMinDepth.Desc(),
+		prometheus.GaugeValue,
+		minDepth,
+	)
+}
@@ -0,0 +1,169 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type TransactionPoolBacklogCollector struct {
+	client   Client
+	source   poolSource
+	metricsC chan<- prometheus.Metric
+
+	median  uint64
+	target  time.Duration
+	backlog []backlogEntry
+	tiers   []feeTier
+}
+
+var _ CustomCollector = (*TransactionPoolBacklogCollector)(nil)
+
+func NewTransactionPoolBacklogCollector(
+	client Client, metricsC chan<- prometheus.Metric, source poolSource,
+) *TransactionPoolBacklogCollector {
+	return &TransactionPoolBacklogCollector{
+		client:   client,
+		source:   source,
+		metricsC: metricsC,
+	}
+}
+
+func (c *TransactionPoolBacklogCollector) Name() string {
+	return "transaction_pool_backlog"
+}
+
+func (c *TransactionPoolBacklogCollector) Collect(ctx context.Context) error {
+	err := c.fetchData(ctx)
+	if err != nil {
+		return fmt.Errorf("fetch data: %w", err)
+	}
+
+	c.collectClearance()
+	c.collectInclusion()
+
+	return nil
+}
+
+func (c *TransactionPoolBacklogCollector) fetchData(ctx context.Context) error {
+	info, err := c.client.GetInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("get info: %w", err)
+	}
+
+	// `block_size_median` is what older nodes report, being the same as
+	// `block_weight_median` for those that report both.
+	//
+	median := info.BlockWeightMedian
+	if median == 0 {
+		median = info.BlockSizeMedian
+	}
+
+	if median == 0 {
+		return fmt.Errorf("no block weight median")
+	}
+
+	estimate, err := getFeeEstimate(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("get fee estimate: %w", err)
+	}
+
+	backlog, err := c.fetchBacklog(ctx)
+	if err != nil {
+		return fmt.Errorf("fetch backlog: %w", err)
+	}
+
+	c.median = median
+	c.target = time.Duration(info.Target) * time.Second
+	c.backlog = backlog
+	c.tiers = estimate.Tiers()
+
+	return nil
+}
+
+// fetchBacklog retrieves the transactions waiting in the pool (see
+// `backlogEntry`).
+//
+// ps.: transactions that the pool source doesn't keep track of (see
+// `poolView.Untracked`) are left out.
+//
+func (c *TransactionPoolBacklogCollector) fetchBacklog(
+	ctx context.Context,
+) ([]backlogEntry, error) {
+	view, err := c.source.View(ctx, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("pool view: %w", err)
+	}
+
+	backlog := make([]backlogEntry, 0, len(view.transactions))
+	for _, txn := range view.transactions {
+		weight := txn.weight
+		if weight == 0 {
+			weight = txn.blobSize
+		}
+
+		backlog = append(backlog, backlogEntry{
+			weight: weight,
+			fee:    txn.fee,
+		})
+	}
+
+	return backlog, nil
+}
+
+// collectClearance reports the weight of the backlog along with the number
+// of blocks (and time) it'd take to clear it, assuming that no more
+// transactions come in and that blocks are filled up to the median weight
+// (i.e., without miners incurring a penalty).
+//
+func (c *TransactionPoolBacklogCollector) collectClearance() {
+	weight := uint64(0)
+	for _, entry := range c.backlog {
+		weight += entry.weight
+	}
+
+	blocks := (weight + c.median - 1) / c.median
+
+	c.metricsC <- prometheus.MustNewConstMetric(
+		metricTransactionPoolBacklogWeightBytes.Desc(),
+		prometheus.GaugeValue,
+		float64(weight),
+	)
+
+	c.metricsC <- prometheus.MustNewConstMetric(
+		metricTransactionPoolBacklogBlocks.Desc(),
+		prometheus.GaugeValue,
+		float64(blocks),
+	)
+
+	c.metricsC <- prometheus.MustNewConstMetric(
+		metricTransactionPoolBacklogClearanceSeconds.Desc(),
+		prometheus.GaugeValue,
+		float64(blocks)*c.target.Seconds(),
+	)
+}
+
+// collectInclusion reports, for each fee tier, the number of blocks until a
+// transaction paying the tier's fee would be mined: as miners pick the
+// transactions that pay the most per byte first, only those paying at least
+// as much stand ahead of it.
+//
+func (c *TransactionPoolBacklogCollector) collectInclusion() {
+	for _, tier := range c.tiers {
+		ahead := uint64(0)
+		for _, entry := range c.backlog {
+			if entry.FeePerByte() >= float64(tier.feePerByte) {
+				ahead += entry.weight
+			}
+		}
+
+		c.metricsC <- prometheus.MustNewConstMetric(
+			metricTransactionPoolBacklogInclusionBlocks.Desc(),
+			prometheus.GaugeValue,
+			float64(ahead/c.median+1),
+			tier.name,
+		)
+	}
+}
@@ -0,0 +1,151 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cirocosta/go-monero/pkg/constant"
+	"github.com/cirocosta/go-monero/pkg/rpc/daemon"
+)
+
+type BlockWindowCollector struct {
+	client   Client
+	window   *blockWindow
+	metricsC chan<- prometheus.Metric
+
+	headers []daemon.BlockHeader
+	blocks  []windowBlock
+}
+
+var _ CustomCollector = (*BlockWindowCollector)(nil)
+
+func NewBlockWindowCollector(
+	client Client, metricsC chan<- prometheus.Metric, window *blockWindow,
+) *BlockWindowCollector {
+	return &BlockWindowCollector{
+		client:   client,
+		window:   window,
+		metricsC: metricsC,
+	}
+}
+
+func (c *BlockWindowCollector) Name() string {
+	return "blockwindow"
+}
+
+// Collect reports the metrics derived from the headers in the window first,
+// so that only those about fees go missing when fees can't be looked up
+// (e.g., on restricted RPC ports).
+//
+func (c *BlockWindowCollector) Collect(ctx context.Context) error {
+	headers, err := c.window.Headers(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("block window headers: %w", err)
+	}
+
+	c.headers = headers
+
+	c.collectBlocks()
+	c.collectBlockSizes()
+	c.collectTransactionsCount()
+	c.collectIntervals()
+
+	blocks, err := c.window.Fees(ctx, c.client, headers)
+	if err != nil {
+		return fmt.Errorf("block window fees: %w", err)
+	}
+
+	c.blocks = blocks
+
+	c.collectFees()
+	c.collectFeesPerByte()
+
+	return nil
+}
+
+func (c *BlockWindowCollector) collectBlocks() {
+	c.metricsC <- prometheus.MustNewConstMetric(
+		metricBlockwindowBlocks.Desc(),
+		prometheus.GaugeValue,
+		float64(len(c.headers)),
+	)
+}
+
+func (c *BlockWindowCollector) collectBlockSizes() {
+	summary := NewSummary()
+	for _, header := range c.headers {
+		summary.Insert(float64(header.BlockSize))
+	}
+
+	c.metricsC <- prometheus.MustNewConstSummary(
+		metricBlockwindowSizeBytes.Desc(),
+		summary.Count(), summary.Sum(), summary.Quantiles(),
+	)
+}
+
+func (c *BlockWindowCollector) collectTransactionsCount() {
+	summary := NewSummary()
+	for _, header := range c.headers {
+		summary.Insert(float64(header.NumTxes))
+	}
+
+	c.metricsC <- prometheus.MustNewConstSummary(
+		metricBlockwindowTransactions.Desc(),
+		summary.Count(), summary.Sum(), summary.Quantiles(),
+	)
+}
+
+func (c *BlockWindowCollector) collectFees() {
+	summary := NewSummary()
+	for _, block := range c.blocks {
+		summary.Insert(float64(block.fees) / constant.XMR)
+	}
+
+	c.metricsC <- prometheus.MustNewConstSummary(
+		metricBlockwindowFeesMonero.Desc(),
+		summary.Count(), summary.Sum(), summary.Quantiles(),
+	)
+}
+
+// collectFeesPerByte reports the distribution of the fees paid per byte of
+// block, taking into account only blocks that include transactions (i.e.,
+// not just the miner's).
+//
+func (c *BlockWindowCollector) collectFeesPerByte() {
+	summary := NewSummary()
+	for _, block := range c.blocks {
+		if block.header.NumTxes == 0 || block.header.BlockSize == 0 {
+			continue
+		}
+
+		summary.Insert(
+			float64(block.fees) / float64(block.header.BlockSize),
+		)
+	}
+
+	c.metricsC <- prometheus.MustNewConstSummary(
+		metricBlockwindowFeesPiconeroPerByte.Desc(),
+		summary.Count(), summary.Sum(), summary.Quantiles(),
+	)
+}
+
+// collectIntervals reports the distribution of the time between consecutive
+// blocks.
+//
+// ps.: as timestamps are set by miners, intervals may well be negative.
+//
+func (c *BlockWindowCollector) collectIntervals() {
+	summary := NewSummary()
+	for idx := 1; idx < len(c.headers); idx++ {
+		summary.Insert(float64(
+			c.headers[idx].Timestamp - c.headers[idx-1].Timestamp,
+		))
+	}
+
+	c.metricsC <- prometheus.MustNewConstSummary(
+		metricBlockwindowIntervalSeconds.Desc(),
+		summary.Count(), summary.Sum(), summary.Quantiles(),
+	)
+}
@@ -3,6 +3,7 @@ package collector
 import (
 	"context"
 	"fmt"
+	"net"
 
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -10,8 +11,11 @@ import (
 )
 
 type ConnectionsCollector struct {
-	client   *daemon.Client
-	metricsC chan<- prometheus.Metric
+	client        Client
+	metricsC      chan<- prometheus.Metric
+	countryMapper CountryMapper
+	asnMapper     ASNMapper
+	asnTopN       int
 
 	connections *daemon.GetConnectionsResult
 }
@@ -19,11 +23,18 @@ type ConnectionsCollector struct {
 var _ CustomCollector = (*ConnectionsCollector)(nil)
 
 func NewConnectionsCollector(
-	client *daemon.Client, metricsC chan<- prometheus.Metric,
+	client Client,
+	metricsC chan<- prometheus.Metric,
+	countryMapper CountryMapper,
+	asnMapper ASNMapper,
+	asnTopN int,
 ) *ConnectionsCollector {
 	return &ConnectionsCollector{
-		client:   client,
-		metricsC: metricsC,
+		client:        client,
+		metricsC:      metricsC,
+		countryMapper: countryMapper,
+		asnMapper:     asnMapper,
+		asnTopN:       asnTopN,
 	}
 }
 
@@ -38,6 +49,7 @@ func (c *ConnectionsCollector) Collect(ctx context.Context) error {
 	}
 
 	c.collectConnectionsCount()
+	c.collectConnectionsASN()
 	c.collectHeightDistribution()
 	c.collectDataRates()
 	c.collectConnectionAges()
@@ -53,11 +65,7 @@ func (c *ConnectionsCollector) collectConnectionAges() {
 	}
 
 	c.metricsC <- prometheus.MustNewConstSummary(
-		prometheus.NewDesc(
-			"monero_p2p_connections_age",
-			"distribution of age of the connections we have",
-			nil, nil,
-		),
+		metricP2PConnectionsAge.Desc(),
 		summary.Count(), summary.Sum(), summary.Quantiles(),
 	)
 }
@@ -72,20 +80,12 @@ func (c *ConnectionsCollector) collectDataRates() {
 	}
 
 	c.metricsC <- prometheus.MustNewConstSummary(
-		prometheus.NewDesc(
-			"monero_p2p_connections_rx_rate_bps",
-			"distribution of data receive rate in bytes/s",
-			nil, nil,
-		),
+		metricP2PConnectionsRxRateBps.Desc(),
 		summaryRx.Count(), summaryRx.Sum(), summaryRx.Quantiles(),
 	)
 
 	c.metricsC <- prometheus.MustNewConstSummary(
-		prometheus.NewDesc(
-			"monero_p2p_connections_tx_rate_bps",
-			"distribution of data transmit rate in bytes/s",
-			nil, nil,
-		),
+		metricP2PConnectionsTxRateBps.Desc(),
 		summaryTx.Count(), summaryTx.Sum(), summaryTx.Quantiles(),
 	)
 }
@@ -97,26 +97,18 @@ func (c *ConnectionsCollector) collectHeightDistribution() {
 	}
 
 	c.metricsC <- prometheus.MustNewConstSummary(
-		prometheus.NewDesc(
-			"monero_p2p_connections_height",
-			"distribution the height of the peers "+
-				"connected to/from us",
-			nil, nil,
-		),
+		metricP2PConnectionsHeight.Desc(),
 		summary.Count(), summary.Sum(), summary.Quantiles(),
 	)
 }
 
 func (c *ConnectionsCollector) collectConnectionsCount() {
-	desc := prometheus.NewDesc(
-		"monero_p2p_connections",
-		"number of connections to/from this node",
-		[]string{"type", "state"}, nil,
-	)
+	desc := metricP2PConnections.Desc()
 
 	type key struct {
-		ttype string
-		state string
+		ttype   string
+		state   string
+		country string
 	}
 
 	counters := map[key]float64{}
@@ -127,7 +119,12 @@ func (c *ConnectionsCollector) collectConnectionsCount() {
 			ttype = "out"
 		}
 
-		counters[key{ttype, conn.State}]++
+		country, err := c.countryMapper(net.ParseIP(conn.Host))
+		if err != nil {
+			country = unknownCountry
+		}
+
+		counters[key{ttype, conn.State, country}]++
 	}
 
 	for k, v := range counters {
@@ -135,7 +132,37 @@ func (c *ConnectionsCollector) collectConnectionsCount() {
 			desc,
 			prometheus.GaugeValue,
 			v,
-			k.ttype, k.state,
+			k.ttype, k.state, k.country,
+		)
+	}
+}
+
+func (c *ConnectionsCollector) collectConnectionsASN() {
+	if c.asnMapper == nil {
+		return
+	}
+
+	desc := metricP2PConnectionsASN.Desc()
+
+	counters := map[ASN]float64{}
+
+	for _, conn := range c.connections.Connections {
+		asn, err := c.asnMapper(net.ParseIP(conn.Host))
+		if err != nil {
+			asn = unknownASN
+		}
+
+		counters[asn]++
+	}
+
+	for asn, v := range topASNs(counters, c.asnTopN) {
+		number, org := asn.labels()
+
+		c.metricsC <- prometheus.MustNewConstMetric(
+			desc,
+			prometheus.GaugeValue,
+			v,
+			number, org,
 		)
 	}
 }
@@ -0,0 +1,156 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cirocosta/go-monero/pkg/constant"
+)
+
+// secondsPerYear is the number of seconds in a (julian) year.
+//
+const secondsPerYear = 365.25 * 24 * 60 * 60
+
+type EmissionCollector struct {
+	client   Client
+	tracker  *emissionTracker
+	window   *blockWindow
+	metricsC chan<- prometheus.Metric
+
+	height   uint64
+	target   uint64
+	emission *big.Int
+	fees     *big.Int
+	blocks   []windowBlock
+}
+
+var _ CustomCollector = (*EmissionCollector)(nil)
+
+func NewEmissionCollector(
+	client Client,
+	metricsC chan<- prometheus.Metric,
+	tracker *emissionTracker,
+	window *blockWindow,
+) *EmissionCollector {
+	return &EmissionCollector{
+		client:   client,
+		tracker:  tracker,
+		window:   window,
+		metricsC: metricsC,
+	}
+}
+
+func (c *EmissionCollector) Name() string {
+	return "emission"
+}
+
+func (c *EmissionCollector) Collect(ctx context.Context) error {
+	err := c.fetchData(ctx)
+	if err != nil {
+		return fmt.Errorf("fetch data: %w", err)
+	}
+
+	c.collectTotals()
+	c.collectSubsidy()
+
+	return nil
+}
+
+func (c *EmissionCollector) fetchData(ctx context.Context) error {
+	info, err := c.client.GetInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("get info: %w", err)
+	}
+
+	emission, fees, err := c.tracker.Update(ctx, c.client, info.Height)
+	if err != nil {
+		return fmt.Errorf("update emission: %w", err)
+	}
+
+	headers, err := c.window.Headers(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("block window headers: %w", err)
+	}
+
+	blocks, err := c.window.Fees(ctx, c.client, headers)
+	if err != nil {
+		return fmt.Errorf("block window fees: %w", err)
+	}
+
+	c.height = info.Height
+	c.target = info.Target
+	c.emission = emission
+	c.fees = fees
+	c.blocks = blocks
+
+	return nil
+}
+
+func (c *EmissionCollector) collectTotals() {
+	c.metricsC <- prometheus.MustNewConstMetric(
+		metricEmissionHeight.Desc(),
+		prometheus.GaugeValue,
+		float64(c.height),
+	)
+
+	c.metricsC <- prometheus.MustNewConstMetric(
+		metricEmissionMonero.Desc(),
+		prometheus.GaugeValue,
+		toMonero(c.emission),
+	)
+
+	c.metricsC <- prometheus.MustNewConstMetric(
+		metricEmissionFeesMonero.Desc(),
+		prometheus.GaugeValue,
+		toMonero(c.fees),
+	)
+}
+
+// collectSubsidy reports the average subsidy (newly minted coins, i.e., the
+// reward minus fees) per block across the block window, as well as the
+// yearly inflation rate that it amounts to given the current supply.
+//
+func (c *EmissionCollector) collectSubsidy() {
+	if len(c.blocks) == 0 {
+		return
+	}
+
+	subsidy := float64(0)
+	for _, block := range c.blocks {
+		subsidy += float64(block.header.Reward-block.fees) / constant.XMR
+	}
+	subsidy /= float64(len(c.blocks))
+
+	c.metricsC <- prometheus.MustNewConstMetric(
+		metricEmissionSubsidyMonero.Desc(),
+		prometheus.GaugeValue,
+		subsidy,
+	)
+
+	supply := toMonero(c.emission)
+	if c.target == 0 || supply == 0 {
+		return
+	}
+
+	blocksPerYear := secondsPerYear / float64(c.target)
+
+	c.metricsC <- prometheus.MustNewConstMetric(
+		metricEmissionInflationRatio.Desc(),
+		prometheus.GaugeValue,
+		subsidy*blocksPerYear/supply,
+	)
+}
+
+// toMonero converts an amount in atomic units to monero.
+//
+func toMonero(amount *big.Int) float64 {
+	v, _ := new(big.Float).Quo(
+		new(big.Float).SetInt(amount),
+		big.NewFloat(constant.XMR),
+	).Float64()
+
+	return v
+}
@@ -0,0 +1,108 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type FeeEstimateCollector struct {
+	client   Client
+	source   poolSource
+	metricsC chan<- prometheus.Metric
+
+	estimate *feeEstimate
+	txns     []*poolTransaction
+}
+
+var _ CustomCollector = (*FeeEstimateCollector)(nil)
+
+func NewFeeEstimateCollector(
+	client Client, metricsC chan<- prometheus.Metric, source poolSource,
+) *FeeEstimateCollector {
+	return &FeeEstimateCollector{
+		client:   client,
+		source:   source,
+		metricsC: metricsC,
+	}
+}
+
+func (c *FeeEstimateCollector) Name() string {
+	return "fee_estimate"
+}
+
+func (c *FeeEstimateCollector) Collect(ctx context.Context) error {
+	err := c.fetchData(ctx)
+	if err != nil {
+		return fmt.Errorf("fetch data: %w", err)
+	}
+
+	c.collectTiers()
+	c.collectQuantizationMask()
+	c.collectTransactionPoolTiers()
+
+	return nil
+}
+
+func (c *FeeEstimateCollector) fetchData(ctx context.Context) error {
+	estimate, err := getFeeEstimate(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("get fee estimate: %w", err)
+	}
+
+	view, err := c.source.View(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("pool view: %w", err)
+	}
+
+	c.estimate = estimate
+	c.txns = view.transactions
+
+	return nil
+}
+
+func (c *FeeEstimateCollector) collectTiers() {
+	for _, tier := range c.estimate.Tiers() {
+		c.metricsC <- prometheus.MustNewConstMetric(
+			metricFeeEstimatePiconeroPerByte.Desc(),
+			prometheus.GaugeValue,
+			float64(tier.feePerByte),
+			tier.name,
+		)
+	}
+}
+
+func (c *FeeEstimateCollector) collectQuantizationMask() {
+	c.metricsC <- prometheus.MustNewConstMetric(
+		metricFeeEstimateQuantizationMaskPiconero.Desc(),
+		prometheus.GaugeValue,
+		float64(c.estimate.QuantizationMask),
+	)
+}
+
+// collectTransactionPoolTiers reports how many of the transactions in the
+// pool pay (at least) the fee recommended for each tier, so that what's
+// being paid can be put side by side with what the node expects.
+//
+func (c *FeeEstimateCollector) collectTransactionPoolTiers() {
+	tiers := c.estimate.Tiers()
+
+	counts := map[string]int{feeTierNone: 0}
+	for _, tier := range tiers {
+		counts[tier.name] = 0
+	}
+
+	for _, txn := range c.txns {
+		counts[tierOf(tiers, txn.FeePerByte())]++
+	}
+
+	for tier, count := range counts {
+		c.metricsC <- prometheus.MustNewConstMetric(
+			metricFeeEstimateTransactionPoolTransactions.Desc(),
+			prometheus.GaugeValue,
+			float64(count),
+			tier,
+		)
+	}
+}
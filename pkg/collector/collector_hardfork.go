@@ -0,0 +1,97 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cirocosta/go-monero/pkg/rpc/daemon"
+)
+
+type HardForkCollector struct {
+	client   Client
+	window   *blockWindow
+	metricsC chan<- prometheus.Metric
+
+	info    *daemon.HardForkInfoResult
+	headers []daemon.BlockHeader
+}
+
+var _ CustomCollector = (*HardForkCollector)(nil)
+
+func NewHardForkCollector(
+	client Client, metricsC chan<- prometheus.Metric, window *blockWindow,
+) *HardForkCollector {
+	return &HardForkCollector{
+		client:   client,
+		window:   window,
+		metricsC: metricsC,
+	}
+}
+
+func (c *HardForkCollector) Name() string {
+	return "hardfork"
+}
+
+// Collect reports what `hard_fork_info` tells first, so that it doesn't
+// depend on the block window (only needed for the votes) being up to date.
+//
+func (c *HardForkCollector) Collect(ctx context.Context) error {
+	info, err := c.client.HardForkInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("hard fork info: %w", err)
+	}
+
+	c.info = info
+	c.collectInfo()
+
+	headers, err := c.window.Headers(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("block window headers: %w", err)
+	}
+
+	c.headers = headers
+	c.collectBlockVotes()
+
+	return nil
+}
+
+func (c *HardForkCollector) collectInfo() {
+	for metric, value := range map[*Metric]float64{
+		metricHardforkVersion:        float64(c.info.Version),
+		metricHardforkVotingVersion:  float64(c.info.Voting),
+		metricHardforkEnabled:        boolToFloat64(c.info.Enabled),
+		metricHardforkState:          float64(c.info.State),
+		metricHardforkEarliestHeight: float64(c.info.EarliestHeight),
+		metricHardforkWindowBlocks:   float64(c.info.Window),
+		metricHardforkVotes:          float64(c.info.Votes),
+		metricHardforkThreshold:      float64(c.info.Threshold),
+	} {
+		c.metricsC <- prometheus.MustNewConstMetric(
+			metric.Desc(),
+			prometheus.GaugeValue,
+			value,
+		)
+	}
+}
+
+// collectBlockVotes reports how the blocks in the window are distributed
+// across the versions they signal (i.e., vote for) via their minor version.
+//
+func (c *HardForkCollector) collectBlockVotes() {
+	votes := map[uint]float64{}
+	for _, header := range c.headers {
+		votes[header.MinorVersion]++
+	}
+
+	for version, count := range votes {
+		c.metricsC <- prometheus.MustNewConstMetric(
+			metricHardforkBlockVotes.Desc(),
+			prometheus.GaugeValue,
+			count,
+			strconv.FormatUint(uint64(version), 10),
+		)
+	}
+}
@@ -0,0 +1,59 @@
+package collector_test
+
+import (
+	"testing"
+
+	"github.com/cirocosta/monero-exporter/pkg/collector"
+)
+
+// TestHardForkCollectorWindow checks that what `hard_fork_info` tells is
+// reported regardless of the block window, with the votes only needing the
+// headers in it (not fees).
+//
+func TestHardForkCollectorWindow(t *testing.T) {
+	for _, tc := range []struct {
+		desc        string
+		unavailable string
+		votes       bool
+	}{
+		{
+			desc:        "without fees",
+			unavailable: "get_coinbase_tx_sum",
+			votes:       true,
+		},
+		{
+			desc:        "without headers",
+			unavailable: "get_block_headers_range",
+			votes:       false,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			server := newTestServer(t)
+			server.RemoveResponse(tc.unavailable)
+
+			families := gather(t, newServerCollector(t, server,
+				collector.WithCollectors("hardfork"),
+			))
+
+			for _, name := range []string{
+				"monero_hardfork_version",
+				"monero_hardfork_enabled",
+				"monero_hardfork_votes",
+				"monero_hardfork_threshold",
+			} {
+				if _, found := sample(families, name); !found {
+					t.Errorf("expected %s to be reported", name)
+				}
+			}
+
+			_, found := sample(families,
+				"monero_hardfork_block_votes", "version", "14")
+			if found != tc.votes {
+				t.Errorf("expected votes to be reported: %t, "+
+					"got %t", tc.votes, found)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type InclusionCollector struct {
+	client   Client
+	tracker  *inclusionTracker
+	source   poolSource
+	metricsC chan<- prometheus.Metric
+}
+
+var _ CustomCollector = (*InclusionCollector)(nil)
+
+func NewInclusionCollector(
+	client Client,
+	metricsC chan<- prometheus.Metric,
+	tracker *inclusionTracker,
+	source poolSource,
+) *InclusionCollector {
+	return &InclusionCollector{
+		client:   client,
+		tracker:  tracker,
+		source:   source,
+		metricsC: metricsC,
+	}
+}
+
+func (c *InclusionCollector) Name() string {
+	return "inclusion"
+}
+
+func (c *InclusionCollector) Collect(ctx context.Context) error {
+	if err := c.tracker.Observe(ctx, c.client, c.source); err != nil {
+		return fmt.Errorf("observe: %w", err)
+	}
+
+	c.tracker.delay.Collect(c.metricsC)
+	c.metricsC <- c.tracker.dropped
+
+	return nil
+}
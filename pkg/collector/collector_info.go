@@ -11,7 +11,7 @@ import (
 )
 
 type OverallCollector struct {
-	client   *daemon.Client
+	client   Client
 	metricsC chan<- prometheus.Metric
 
 	info *daemon.GetInfoResult
@@ -20,7 +20,7 @@ type OverallCollector struct {
 var _ CustomCollector = (*OverallCollector)(nil)
 
 func NewOverallCollector(
-	client *daemon.Client, metricsC chan<- prometheus.Metric,
+	client Client, metricsC chan<- prometheus.Metric,
 ) *OverallCollector {
 	return &OverallCollector{
 		client:   client,
@@ -58,11 +58,7 @@ func (c *OverallCollector) collect() {
 	now := time.Now()
 
 	c.metricsC <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			"monero_info_uptime_seconds_total",
-			"for how long this node has been up",
-			nil, nil,
-		),
+		metricInfoUptimeSecondsTotal.Desc(),
 		prometheus.GaugeValue,
 		float64(now.
 			Sub(time.Unix(int64(c.info.StartTime), 0)).
@@ -70,112 +66,67 @@ func (c *OverallCollector) collect() {
 	)
 
 	c.metricsC <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			"monero_info_alternative_blocks",
-			"number of blocks alternative to the longest",
-			nil, nil,
-		),
+		metricInfoAlternativeBlocks.Desc(),
 		prometheus.GaugeValue,
 		float64(c.info.AltBlocksCount),
 	)
 
 	c.metricsC <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			"monero_info_offline",
-			"whether the node is offline",
-			nil, nil,
-		),
+		metricInfoOffline.Desc(),
 		prometheus.GaugeValue,
 		boolToFloat64(c.info.Offline),
 	)
 
 	c.metricsC <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			"monero_info_mainnet",
-			"whether the node is connected to mainnet",
-			nil, nil,
-		),
+		metricInfoMainnet.Desc(),
 		prometheus.GaugeValue,
 		boolToFloat64(c.info.Mainnet),
 	)
 
 	c.metricsC <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			"monero_info_block_size_limit_bytes",
-			"maximum hard limit of a block",
-			nil, nil,
-		),
+		metricInfoBlockSizeLimitBytes.Desc(),
 		prometheus.GaugeValue,
 		float64(c.info.BlockSizeLimit),
 	)
 
 	c.metricsC <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			"monero_info_block_size_median_bytes",
-			"current median size for computing dynamic fees",
-			nil, nil,
-		),
+		metricInfoBlockSizeMedianBytes.Desc(),
 		prometheus.GaugeValue,
 		float64(c.info.BlockSizeMedian),
 	)
 
 	c.metricsC <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			"monero_info_synchronized",
-			"whether the node's chain is in sync with the network",
-			nil, nil,
-		),
+		metricInfoSynchronized.Desc(),
 		prometheus.GaugeValue,
 		boolToFloat64(c.info.Synchronized),
 	)
 
 	c.metricsC <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			"monero_info_height",
-			"current height of the chain",
-			nil, nil,
-		),
+		metricInfoHeight.Desc(),
 		prometheus.GaugeValue,
 		float64(c.info.Height),
 	)
 
 	c.metricsC <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			"monero_info_target_height",
-			"target height to achieve to be considered in sync",
-			nil, nil,
-		),
+		metricInfoTargetHeight.Desc(),
 		prometheus.GaugeValue,
 		float64(c.info.TargetHeight),
 	)
 
 	c.metricsC <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			"monero_info_rpc_connections",
-			"number of rpc connections being served by the node",
-			nil, nil,
-		),
+		metricInfoRPCConnections.Desc(),
 		prometheus.GaugeValue,
 		float64(c.info.RPCConnectionsCount),
 	)
 
 	c.metricsC <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			"monero_info_database_size_bytes",
-			"size of the monero database",
-			nil, nil,
-		),
+		metricInfoDatabaseSizeBytes.Desc(),
 		prometheus.GaugeValue,
 		float64(c.info.DatabaseSize),
 	)
 
 	c.metricsC <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			"monero_info_free_space_bytes",
-			"amount of free space in the partition where "+
-				"monero's database is in",
-			nil, nil,
-		),
+		metricInfoFreeSpaceBytes.Desc(),
 		prometheus.GaugeValue,
 		float64(c.info.FreeSpace),
 	)
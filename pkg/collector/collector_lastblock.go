@@ -11,7 +11,7 @@ import (
 )
 
 type LastBlockStatsCollector struct {
-	client   *daemon.Client
+	client   Client
 	metricsC chan<- prometheus.Metric
 
 	txns     []*daemon.TransactionJSON
@@ -22,7 +22,7 @@ type LastBlockStatsCollector struct {
 var _ CustomCollector = (*LastBlockStatsCollector)(nil)
 
 func NewLastBlockStatsCollector(
-	client *daemon.Client, metricsC chan<- prometheus.Metric,
+	client Client, metricsC chan<- prometheus.Metric,
 ) *LastBlockStatsCollector {
 	return &LastBlockStatsCollector{
 		client:   client,
@@ -51,6 +51,7 @@ func (c *LastBlockStatsCollector) Collect(ctx context.Context) error {
 	c.collectTransactionsInputs()
 	c.collectTransactionsOutputs()
 	c.collectTransactionsSize()
+	c.collectVersions()
 
 	return nil
 }
@@ -99,11 +100,7 @@ func (c *LastBlockStatsCollector) fetchData(ctx context.Context) error {
 }
 
 func (c *LastBlockStatsCollector) collectBlockSize() {
-	desc := prometheus.NewDesc(
-		"monero_lastblock_size_bytes",
-		"total size of the last block",
-		nil, nil,
-	)
+	desc := metricLastblockSizeBytes.Desc()
 
 	c.metricsC <- prometheus.MustNewConstMetric(
 		desc,
@@ -113,11 +110,7 @@ func (c *LastBlockStatsCollector) collectBlockSize() {
 }
 
 func (c *LastBlockStatsCollector) collectDifficulty() {
-	desc := prometheus.NewDesc(
-		"monero_lastblock_difficulty",
-		"difficulty used for the last block",
-		nil, nil,
-	)
+	desc := metricLastblockDifficulty.Desc()
 
 	c.metricsC <- prometheus.MustNewConstMetric(
 		desc,
@@ -127,11 +120,7 @@ func (c *LastBlockStatsCollector) collectDifficulty() {
 }
 
 func (c *LastBlockStatsCollector) collectFees() {
-	desc := prometheus.NewDesc(
-		"monero_lastblock_fees_monero",
-		"total amount of fees included in this block",
-		nil, nil,
-	)
+	desc := metricLastblockFeesMonero.Desc()
 
 	c.metricsC <- prometheus.MustNewConstMetric(
 		desc,
@@ -141,11 +130,7 @@ func (c *LastBlockStatsCollector) collectFees() {
 }
 
 func (c *LastBlockStatsCollector) collectHeight() {
-	desc := prometheus.NewDesc(
-		"monero_lastblock_height",
-		"height of the last block",
-		nil, nil,
-	)
+	desc := metricLastblockHeight.Desc()
 
 	c.metricsC <- prometheus.MustNewConstMetric(
 		desc,
@@ -155,12 +140,7 @@ func (c *LastBlockStatsCollector) collectHeight() {
 }
 
 func (c *LastBlockStatsCollector) collectReward() {
-	desc := prometheus.NewDesc(
-		"monero_lastblock_reward_monero",
-		"total amount of rewards granted in the last block "+
-			"(subsidy + fees)",
-		nil, nil,
-	)
+	desc := metricLastblockRewardMonero.Desc()
 
 	c.metricsC <- prometheus.MustNewConstMetric(
 		desc,
@@ -174,11 +154,7 @@ func (c *LastBlockStatsCollector) collectSubsidy() {
 	fees := float64(c.gatherFees(c.txns))
 	subsidy := (totalReward - fees) / constant.XMR
 
-	desc := prometheus.NewDesc(
-		"monero_lastblock_subsidy_monero",
-		"newly minted monero for this block",
-		nil, nil,
-	)
+	desc := metricLastblockSubsidyMonero.Desc()
 
 	c.metricsC <- prometheus.MustNewConstMetric(
 		desc,
@@ -188,11 +164,7 @@ func (c *LastBlockStatsCollector) collectSubsidy() {
 }
 
 func (c *LastBlockStatsCollector) collectTransactionsCount() {
-	desc := prometheus.NewDesc(
-		"monero_lastblock_transactions",
-		"number of transactions seen in the last block",
-		nil, nil,
-	)
+	desc := metricLastblockTransactions.Desc()
 
 	c.metricsC <- prometheus.MustNewConstMetric(
 		desc,
@@ -211,11 +183,7 @@ func (c *LastBlockStatsCollector) collectTransactionsFeePerKb() {
 	}
 
 	c.metricsC <- prometheus.MustNewConstSummary(
-		prometheus.NewDesc(
-			"monero_lastblock_fees_micronero_per_kb",
-			"distribution of the feeperkb utilized for txns",
-			nil, nil,
-		),
+		metricLastblockFeesMicroneroPerKB.Desc(),
 		summary.Count(), summary.Sum(), summary.Quantiles(),
 	)
 }
@@ -227,11 +195,7 @@ func (c *LastBlockStatsCollector) collectTransactionsSize() {
 	}
 
 	c.metricsC <- prometheus.MustNewConstSummary(
-		prometheus.NewDesc(
-			"monero_lastblock_transactions_size_bytes",
-			"distribution of the size of the transactions included",
-			nil, nil,
-		),
+		metricLastblockTransactionsSizeBytes.Desc(),
 		summary.Count(), summary.Sum(), summary.Quantiles(),
 	)
 }
@@ -243,11 +207,7 @@ func (c *LastBlockStatsCollector) collectTransactionsInputs() {
 	}
 
 	c.metricsC <- prometheus.MustNewConstSummary(
-		prometheus.NewDesc(
-			"monero_lastblock_transactions_inputs",
-			"distribution of inputs in the last block",
-			nil, nil,
-		),
+		metricLastblockTransactionsInputs.Desc(),
 		summary.Count(), summary.Sum(), summary.Quantiles(),
 	)
 }
@@ -259,32 +219,20 @@ func (c *LastBlockStatsCollector) collectTransactionsOutputs() {
 	}
 
 	c.metricsC <- prometheus.MustNewConstSummary(
-		prometheus.NewDesc(
-			"monero_lastblock_transactions_outputs",
-			"distribution of outputs in the last block",
-			nil, nil,
-		),
+		metricLastblockTransactionsOutputs.Desc(),
 		summary.Count(), summary.Sum(), summary.Quantiles(),
 	)
 }
 
 func (c *LastBlockStatsCollector) collectVersions() {
 	c.metricsC <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			"monero_lastblock_version_major",
-			"major version of the block format",
-			nil, nil,
-		),
+		metricLastblockVersionMajor.Desc(),
 		prometheus.GaugeValue,
 		float64(c.header.MajorVersion),
 	)
 
 	c.metricsC <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			"monero_lastblock_version_minor",
-			"minor version of the block format",
-			nil, nil,
-		),
+		metricLastblockVersionMinor.Desc(),
 		prometheus.GaugeValue,
 		float64(c.header.MinorVersion),
 	)
@@ -10,7 +10,7 @@ import (
 )
 
 type NetStatsCollector struct {
-	client   *daemon.Client
+	client   Client
 	metricsC chan<- prometheus.Metric
 
 	stats *daemon.GetNetStatsResult
@@ -19,7 +19,7 @@ type NetStatsCollector struct {
 var _ CustomCollector = (*NetStatsCollector)(nil)
 
 func NewNetStatsCollector(
-	client *daemon.Client, metricsC chan<- prometheus.Metric,
+	client Client, metricsC chan<- prometheus.Metric,
 ) *NetStatsCollector {
 	return &NetStatsCollector{
 		client:   client,
@@ -55,21 +55,13 @@ func (c *NetStatsCollector) fetchData(ctx context.Context) error {
 
 func (c *NetStatsCollector) collectRxTx() {
 	c.metricsC <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			"monero_net_rx_bytes",
-			"number of bytes received by this node",
-			nil, nil,
-		),
+		metricNetRxBytes.Desc(),
 		prometheus.GaugeValue,
 		float64(c.stats.TotalBytesIn),
 	)
 
 	c.metricsC <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			"monero_net_tx_bytes",
-			"number of bytes received by this node",
-			nil, nil,
-		),
+		metricNetTxBytes.Desc(),
 		prometheus.GaugeValue,
 		float64(c.stats.TotalBytesOut),
 	)
@@ -0,0 +1,159 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cirocosta/go-monero/pkg/rpc/daemon"
+)
+
+// networkWindows are the number of blocks (counting from the tip) over which
+// the network's hashrate and block interval are estimated.
+//
+var networkWindows = []int{10, 60, 720}
+
+type NetworkCollector struct {
+	client   Client
+	window   *blockWindow
+	metricsC chan<- prometheus.Metric
+
+	target  time.Duration
+	tip     daemon.BlockHeader
+	headers []daemon.BlockHeader
+}
+
+var _ CustomCollector = (*NetworkCollector)(nil)
+
+func NewNetworkCollector(
+	client Client, metricsC chan<- prometheus.Metric, window *blockWindow,
+) *NetworkCollector {
+	return &NetworkCollector{
+		client:   client,
+		window:   window,
+		metricsC: metricsC,
+	}
+}
+
+func (c *NetworkCollector) Name() string {
+	return "network"
+}
+
+// Collect reports the metrics about the tip first, so that those (e.g., the
+// tip age, which stale tip alerts rely on) don't depend on the block window
+// being up to date.
+//
+func (c *NetworkCollector) Collect(ctx context.Context) error {
+	err := c.fetchData(ctx)
+	if err != nil {
+		return fmt.Errorf("fetch data: %w", err)
+	}
+
+	c.collectTarget()
+	c.collectTipAge()
+	c.collectHashrate()
+
+	headers, err := c.window.Headers(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("block window headers: %w", err)
+	}
+
+	c.headers = headers
+	c.collectWindows()
+
+	return nil
+}
+
+func (c *NetworkCollector) fetchData(ctx context.Context) error {
+	info, err := c.client.GetInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("get info: %w", err)
+	}
+
+	lastBlockHeaderResp, err := c.client.GetLastBlockHeader(ctx)
+	if err != nil {
+		return fmt.Errorf("get last block header: %w", err)
+	}
+
+	c.target = time.Duration(info.Target) * time.Second
+	c.tip = lastBlockHeaderResp.BlockHeader
+
+	return nil
+}
+
+func (c *NetworkCollector) collectTarget() {
+	c.metricsC <- prometheus.MustNewConstMetric(
+		metricNetworkBlockTargetSeconds.Desc(),
+		prometheus.GaugeValue,
+		c.target.Seconds(),
+	)
+}
+
+// collectTipAge reports for how long the tip has been the tip, i.e., the
+// time since the last block was mined (according to its timestamp).
+//
+func (c *NetworkCollector) collectTipAge() {
+	c.metricsC <- prometheus.MustNewConstMetric(
+		metricNetworkTipAgeSeconds.Desc(),
+		prometheus.GaugeValue,
+		time.Since(time.Unix(c.tip.Timestamp, 0)).Seconds(),
+	)
+}
+
+// collectHashrate reports the hashrate estimated from the difficulty of the
+// tip alone, i.e., the hashrate needed to mine it in the target time.
+//
+func (c *NetworkCollector) collectHashrate() {
+	if c.target <= 0 {
+		return
+	}
+
+	c.metricsC <- prometheus.MustNewConstMetric(
+		metricNetworkHashrate.Desc(),
+		prometheus.GaugeValue,
+		float64(c.tip.Difficulty)/c.target.Seconds(),
+	)
+}
+
+// collectWindows reports, for each one of the windows that fit in the block
+// window, the hashrate estimated from the work put into the blocks in it, and
+// the average interval between them.
+//
+func (c *NetworkCollector) collectWindows() {
+	for _, size := range networkWindows {
+		if size < 2 || len(c.headers) < size {
+			continue
+		}
+
+		headers := c.headers[len(c.headers)-size:]
+		span := float64(headers[len(headers)-1].Timestamp -
+			headers[0].Timestamp)
+		if span <= 0 {
+			continue
+		}
+
+		work := float64(0)
+		for _, header := range headers[1:] {
+			work += float64(header.Difficulty)
+		}
+
+		label := strconv.Itoa(size)
+
+		c.metricsC <- prometheus.MustNewConstMetric(
+			metricNetworkHashrateWindow.Desc(),
+			prometheus.GaugeValue,
+			work/span,
+			label,
+		)
+
+		c.metricsC <- prometheus.MustNewConstMetric(
+			metricNetworkBlockIntervalSeconds.Desc(),
+			prometheus.GaugeValue,
+			span/float64(size-1),
+			label,
+		)
+	}
+}
@@ -0,0 +1,58 @@
+package collector_test
+
+import (
+	"testing"
+
+	"github.com/cirocosta/monero-exporter/pkg/collector"
+)
+
+// TestNetworkCollectorTip checks that the metrics about the tip are reported
+// regardless of the block window, which fees (e.g., on restricted RPC ports)
+// and headers (e.g., when timing out on the first fill) may keep from being
+// up to date.
+//
+func TestNetworkCollectorTip(t *testing.T) {
+	for _, tc := range []struct {
+		desc        string
+		unavailable string
+		windows     bool
+	}{
+		{
+			desc:        "without fees",
+			unavailable: "get_coinbase_tx_sum",
+			windows:     true,
+		},
+		{
+			desc:        "without headers",
+			unavailable: "get_block_headers_range",
+			windows:     false,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			server := newTestServer(t)
+			server.RemoveResponse(tc.unavailable)
+
+			families := gather(t, newServerCollector(t, server,
+				collector.WithCollectors("network"),
+			))
+
+			for _, name := range []string{
+				"monero_network_tip_age_seconds",
+				"monero_network_hashrate",
+			} {
+				if _, found := sample(families, name); !found {
+					t.Errorf("expected %s to be reported", name)
+				}
+			}
+
+			_, found := sample(families,
+				"monero_network_hashrate_window", "blocks", "10")
+			if found != tc.windows {
+				t.Errorf("expected windows to be reported: %t, "+
+					"got %t", tc.windows, found)
+			}
+		})
+	}
+}
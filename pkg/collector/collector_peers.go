@@ -3,6 +3,7 @@ package collector
 import (
 	"context"
 	"fmt"
+	"net"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -11,8 +12,11 @@ import (
 )
 
 type PeersCollector struct {
-	client   *daemon.Client
-	metricsC chan<- prometheus.Metric
+	client        Client
+	metricsC      chan<- prometheus.Metric
+	countryMapper CountryMapper
+	asnMapper     ASNMapper
+	asnTopN       int
 
 	graylist  []daemon.Peer
 	whitelist []daemon.Peer
@@ -21,11 +25,18 @@ type PeersCollector struct {
 var _ CustomCollector = (*PeersCollector)(nil)
 
 func NewPeersCollector(
-	client *daemon.Client, metricsC chan<- prometheus.Metric,
+	client Client,
+	metricsC chan<- prometheus.Metric,
+	countryMapper CountryMapper,
+	asnMapper ASNMapper,
+	asnTopN int,
 ) *PeersCollector {
 	return &PeersCollector{
-		client:   client,
-		metricsC: metricsC,
+		client:        client,
+		metricsC:      metricsC,
+		countryMapper: countryMapper,
+		asnMapper:     asnMapper,
+		asnTopN:       asnTopN,
 	}
 }
 
@@ -40,6 +51,7 @@ func (c *PeersCollector) Collect(ctx context.Context) error {
 	}
 
 	c.collectPeersCount()
+	c.collectPeersASN()
 	c.collectPeersLastSeen()
 
 	return nil
@@ -58,25 +70,71 @@ func (c *PeersCollector) fetchData(ctx context.Context) error {
 }
 
 func (c *PeersCollector) collectPeersCount() {
-	desc := prometheus.NewDesc(
-		"monero_peerlist",
-		"number of node entries in the peerlist",
-		[]string{"type"}, nil,
-	)
+	desc := metricPeerlist.Desc()
+
+	for ttype, peers := range map[string][]daemon.Peer{
+		"white": c.whitelist,
+		"gray":  c.graylist,
+	} {
+		for country, count := range c.countByCountry(peers) {
+			c.metricsC <- prometheus.MustNewConstMetric(
+				desc,
+				prometheus.GaugeValue,
+				count,
+				ttype, country,
+			)
+		}
+	}
+}
 
-	c.metricsC <- prometheus.MustNewConstMetric(
-		desc,
-		prometheus.GaugeValue,
-		float64(len(c.whitelist)),
-		"white",
-	)
+func (c *PeersCollector) countByCountry(peers []daemon.Peer) map[string]float64 {
+	counters := map[string]float64{}
 
-	c.metricsC <- prometheus.MustNewConstMetric(
-		desc,
-		prometheus.GaugeValue,
-		float64(len(c.graylist)),
-		"gray",
-	)
+	for _, peer := range peers {
+		country, err := c.countryMapper(net.ParseIP(peer.Host))
+		if err != nil {
+			country = unknownCountry
+		}
+
+		counters[country]++
+	}
+
+	return counters
+}
+
+func (c *PeersCollector) collectPeersASN() {
+	if c.asnMapper == nil {
+		return
+	}
+
+	desc := metricPeerlistASN.Desc()
+
+	for ttype, peers := range map[string][]daemon.Peer{
+		"white": c.whitelist,
+		"gray":  c.graylist,
+	} {
+		counters := map[ASN]float64{}
+
+		for _, peer := range peers {
+			asn, err := c.asnMapper(net.ParseIP(peer.Host))
+			if err != nil {
+				asn = unknownASN
+			}
+
+			counters[asn]++
+		}
+
+		for asn, count := range topASNs(counters, c.asnTopN) {
+			number, org := asn.labels()
+
+			c.metricsC <- prometheus.MustNewConstMetric(
+				desc,
+				prometheus.GaugeValue,
+				count,
+				ttype, number, org,
+			)
+		}
+	}
 }
 
 func (c *PeersCollector) collectPeersLastSeen() {
@@ -90,11 +148,7 @@ func (c *PeersCollector) collectPeersLastSeen() {
 	}
 
 	c.metricsC <- prometheus.MustNewConstSummary(
-		prometheus.NewDesc(
-			"monero_peerlist_lastseen",
-			"distribution of when our peers have been seen",
-			nil, nil,
-		),
+		metricPeerlistLastseen.Desc(),
 		summary.Count(), summary.Sum(), summary.Quantiles(),
 	)
 }
@@ -0,0 +1,41 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type ReorgsCollector struct {
+	client   Client
+	tracker  *reorgTracker
+	metricsC chan<- prometheus.Metric
+}
+
+var _ CustomCollector = (*ReorgsCollector)(nil)
+
+func NewReorgsCollector(
+	client Client, metricsC chan<- prometheus.Metric, tracker *reorgTracker,
+) *ReorgsCollector {
+	return &ReorgsCollector{
+		client:   client,
+		tracker:  tracker,
+		metricsC: metricsC,
+	}
+}
+
+func (c *ReorgsCollector) Name() string {
+	return "reorgs"
+}
+
+func (c *ReorgsCollector) Collect(ctx context.Context) error {
+	if err := c.tracker.Observe(ctx, c.client); err != nil {
+		return fmt.Errorf("observe: %w", err)
+	}
+
+	c.metricsC <- c.tracker.reorgs
+	c.metricsC <- c.tracker.reorgDepth
+
+	return nil
+}
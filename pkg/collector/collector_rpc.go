@@ -11,7 +11,7 @@ import (
 )
 
 type RPCCollector struct {
-	client   *daemon.Client
+	client   Client
 	metricsC chan<- prometheus.Metric
 
 	accessTracking *daemon.RPCAccessTrackingResult
@@ -20,7 +20,7 @@ type RPCCollector struct {
 var _ CustomCollector = (*RPCCollector)(nil)
 
 func NewRPCCollector(
-	client *daemon.Client, metricsC chan<- prometheus.Metric,
+	client Client, metricsC chan<- prometheus.Metric,
 ) *RPCCollector {
 	return &RPCCollector{
 		client:   client,
@@ -44,19 +44,9 @@ func (c *RPCCollector) Collect(ctx context.Context) error {
 }
 
 func (c *RPCCollector) collectRPC() {
-	countDesc := prometheus.NewDesc(
-		"monero_rpc_hits_total",
-		"number of hits that a particular rpc "+
-			"method had since startup",
-		[]string{"method"}, nil,
-	)
-
-	timeDesc := prometheus.NewDesc(
-		"monero_rpc_seconds_total",
-		"amount of time spent service the method "+
-			"since startup",
-		[]string{"method"}, nil,
-	)
+	countDesc := metricRPCHitsTotal.Desc()
+
+	timeDesc := metricRPCSecondsTotal.Desc()
 
 	for _, d := range c.accessTracking.Data {
 		c.metricsC <- prometheus.MustNewConstMetric(
@@ -0,0 +1,372 @@
+package collector_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/cirocosta/go-monero/pkg/rpc"
+	"github.com/cirocosta/go-monero/pkg/rpc/daemon"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/prometheus/client_golang/prometheus/testutil/promlint"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+
+	"github.com/cirocosta/monero-exporter/pkg/collector"
+	"github.com/cirocosta/monero-exporter/pkg/fakemonerod"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// volatile are the metrics whose values depend on when they're collected
+// (e.g., for how long transactions have been in the pool), thus, left out of
+// the golden files.
+//
+var volatile = map[string]bool{
+	"monero_info_uptime_seconds_total":         true,
+	"monero_network_tip_age_seconds":           true,
+	"monero_peerlist_lastseen":                 true,
+	"monero_transaction_pool_state_age":        true,
+	"monero_transaction_pool_transactions_age": true,
+}
+
+// newTestServer starts a fake monerod serving the default fixtures, closed
+// once the test is done.
+//
+func newTestServer(t *testing.T) *fakemonerod.Server {
+	t.Helper()
+
+	server, err := fakemonerod.New()
+	if err != nil {
+		t.Fatalf("fakemonerod: %v", err)
+	}
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// newTestCollector creates a collector that talks to a fake monerod of its
+// own (see `newServerCollector`).
+//
+func newTestCollector(
+	t *testing.T, opts ...collector.Option,
+) *collector.Collector {
+	t.Helper()
+
+	return newServerCollector(t, newTestServer(t), opts...)
+}
+
+// newServerCollector creates a collector that talks to `server`, with
+// addresses being mapped to a fixed country and autonomous system.
+//
+func newServerCollector(
+	t *testing.T, server *fakemonerod.Server, opts ...collector.Option,
+) *collector.Collector {
+	t.Helper()
+
+	client, err := rpc.NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("rpc client: %v", err)
+	}
+
+	opts = append([]collector.Option{
+		collector.WithCountryMapper(func(_ net.IP) (string, error) {
+			return "NL", nil
+		}),
+		collector.WithASNMapper(func(_ net.IP) (collector.ASN, error) {
+			return collector.ASN{
+				Number:       24940,
+				Organization: "Hetzner Online GmbH",
+			}, nil
+		}),
+	}, opts...)
+
+	c, err := collector.New(daemon.NewClient(client), opts...)
+	if err != nil {
+		t.Fatalf("collector: %v", err)
+	}
+
+	return c
+}
+
+// TestCollectorsGolden scrapes each custom collector against the fixtures
+// served by the fake monerod, comparing the exposition of its metrics to the
+// one under `testdata/<collector>.prom` (`go test -update` rewrites them).
+//
+func TestCollectorsGolden(t *testing.T) {
+	owners := map[string]string{}
+	for _, metric := range collector.Catalog() {
+		owners[metric.Name] = metric.Collector
+	}
+
+	for _, name := range collector.Names() {
+		name := name
+
+		t.Run(name, func(t *testing.T) {
+			registry := prometheus.NewPedanticRegistry()
+			registry.MustRegister(newTestCollector(t,
+				collector.WithCollectors(name),
+			))
+
+			families, err := registry.Gather()
+			if err != nil {
+				t.Fatalf("gather: %v", err)
+			}
+
+			actual := &bytes.Buffer{}
+			for _, family := range families {
+				if owners[family.GetName()] != name ||
+					volatile[family.GetName()] {
+					continue
+				}
+
+				_, err := expfmt.MetricFamilyToText(actual, family)
+				if err != nil {
+					t.Fatalf("to text: %v", err)
+				}
+			}
+
+			golden := filepath.Join("testdata", name+".prom")
+			if *update {
+				err := os.WriteFile(golden, actual.Bytes(), 0o644)
+				if err != nil {
+					t.Fatalf("write golden: %v", err)
+				}
+			}
+
+			expected, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("read golden: %v", err)
+			}
+
+			if !bytes.Equal(actual.Bytes(), expected) {
+				t.Errorf("%s differs from the collected metrics:\n%s",
+					golden, actual.String())
+			}
+		})
+	}
+}
+
+// TestCollectorChecked registers the collector with a pedantic registry,
+// which, with every metric being described upfront, checks what's collected
+// against the descriptions (for all of the collectors as well as for each
+// one on its own).
+//
+func TestCollectorChecked(t *testing.T) {
+	sets := [][]string{collector.Names()}
+	for _, name := range collector.Names() {
+		sets = append(sets, []string{name})
+	}
+
+	for _, names := range sets {
+		registry := prometheus.NewPedanticRegistry()
+		registry.MustRegister(newTestCollector(t,
+			collector.WithCollectors(names...),
+		))
+
+		if _, err := registry.Gather(); err != nil {
+			t.Errorf("gather %v: %v", names, err)
+		}
+	}
+}
+
+// TestCollectorLint locks in the problems that promlint finds with the
+// metrics exposed, all of which predate the catalog, with the metrics being
+// kept as they are so that existing queries don't break.
+//
+func TestCollectorLint(t *testing.T) {
+	expected := []promlint.Problem{
+		{
+			Metric: "monero_info_uptime_seconds_total",
+			Text:   `non-counter metrics should not have "_total" suffix`,
+		},
+		{
+			Metric: "monero_lastblock_fees_micronero_per_kb",
+			Text:   "metric names should not contain abbreviated units",
+		},
+		{
+			Metric: "monero_rpc_hits_total",
+			Text:   `non-counter metrics should not have "_total" suffix`,
+		},
+		{
+			Metric: "monero_rpc_seconds_total",
+			Text:   `non-counter metrics should not have "_total" suffix`,
+		},
+		{
+			Metric: "monero_transaction_pool_fees_micronero_per_kb",
+			Text:   "metric names should not contain abbreviated units",
+		},
+	}
+
+	problems, err := testutil.CollectAndLint(newTestCollector(t))
+	if err != nil {
+		t.Fatalf("collect and lint: %v", err)
+	}
+
+	if !reflect.DeepEqual(problems, expected) {
+		t.Errorf("expected lint problems %v, got %v", expected, problems)
+	}
+}
+
+// sample retrieves the value of the metric named `name` whose labels match
+// `labels` (name and value pairs), with histograms and summaries going by
+// their count.
+//
+func sample(
+	families []*dto.MetricFamily, name string, labels ...string,
+) (float64, bool) {
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+
+		for _, m := range family.GetMetric() {
+			if !hasLabels(m, labels) {
+				continue
+			}
+
+			switch family.GetType() {
+			case dto.MetricType_COUNTER:
+				return m.GetCounter().GetValue(), true
+			case dto.MetricType_HISTOGRAM:
+				return float64(m.GetHistogram().GetSampleCount()), true
+			case dto.MetricType_SUMMARY:
+				return float64(m.GetSummary().GetSampleCount()), true
+			default:
+				return m.GetGauge().GetValue(), true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+func hasLabels(m *dto.Metric, labels []string) bool {
+	values := map[string]string{}
+	for _, pair := range m.GetLabel() {
+		values[pair.GetName()] = pair.GetValue()
+	}
+
+	for idx := 0; idx+1 < len(labels); idx += 2 {
+		if values[labels[idx]] != labels[idx+1] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// bucket retrieves the cumulative count of the bucket with upper bound `le`
+// of the histogram named `name`.
+//
+func bucket(
+	families []*dto.MetricFamily, name string, le float64, labels ...string,
+) (uint64, bool) {
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+
+		for _, m := range family.GetMetric() {
+			if !hasLabels(m, labels) {
+				continue
+			}
+
+			for _, b := range m.GetHistogram().GetBucket() {
+				if b.GetUpperBound() == le {
+					return b.GetCumulativeCount(), true
+				}
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// testBlock is a block of the chain served by the fake monerod in tests.
+//
+type testBlock struct {
+	height uint64
+	hash   string
+	prev   string
+	txs    []string
+}
+
+// chainOf builds a chain of blocks named after `prefix` and their height,
+// going from `from` to `to` (inclusive) on top of `parent`.
+//
+func chainOf(parent testBlock, prefix string, from, to uint64) []testBlock {
+	blocks := []testBlock{}
+	prev := parent.hash
+
+	for height := from; height <= to; height++ {
+		block := testBlock{
+			height: height,
+			hash:   fmt.Sprintf("%s%d", prefix, height),
+			prev:   prev,
+		}
+
+		blocks = append(blocks, block)
+		prev = block.hash
+	}
+
+	return blocks
+}
+
+// setChain has the fake monerod serve `blocks` as the main chain, with the
+// last one being the tip.
+//
+// ps.: as the fake doesn't look at the parameters of the requests, every
+// header range covers the whole chain, and every block looked up is the tip.
+//
+func setChain(t *testing.T, server *fakemonerod.Server, blocks []testBlock) {
+	t.Helper()
+
+	headers := make([]map[string]interface{}, len(blocks))
+	for idx, block := range blocks {
+		headers[idx] = map[string]interface{}{
+			"height":     block.height,
+			"hash":       block.hash,
+			"prev_hash":  block.prev,
+			"num_txes":   len(block.txs),
+			"timestamp":  1640000000 + block.height*120,
+			"difficulty": 300000000000,
+		}
+	}
+
+	tip := blocks[len(blocks)-1]
+
+	tipJSON, err := json.Marshal(map[string]interface{}{
+		"tx_hashes": append([]string{}, tip.txs...),
+	})
+	if err != nil {
+		t.Fatalf("marshal block json: %v", err)
+	}
+
+	for name, response := range map[string]interface{}{
+		"get_last_block_header": map[string]interface{}{
+			"status":       "OK",
+			"block_header": headers[len(headers)-1],
+		},
+		"get_block_headers_range": map[string]interface{}{
+			"status":  "OK",
+			"headers": headers,
+		},
+		"get_block": map[string]interface{}{
+			"status":       "OK",
+			"block_header": headers[len(headers)-1],
+			"json":         string(tipJSON),
+		},
+	} {
+		if err := server.SetResponse(name, response); err != nil {
+			t.Fatalf("set response %s: %v", name, err)
+		}
+	}
+}
@@ -2,7 +2,6 @@ package collector
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
@@ -13,21 +12,23 @@ import (
 )
 
 type TransactionPoolCollector struct {
-	client   *daemon.Client
+	client   Client
+	source   poolSource
 	metricsC chan<- prometheus.Metric
 
-	txns  []*daemon.TransactionJSON
-	pool  *daemon.GetTransactionPoolResult
+	view  *poolView
+	txns  []*poolTransaction
 	stats *daemon.GetTransactionPoolStatsResult
 }
 
 var _ CustomCollector = (*TransactionPoolCollector)(nil)
 
 func NewTransactionPoolCollector(
-	client *daemon.Client, metricsC chan<- prometheus.Metric,
+	client Client, metricsC chan<- prometheus.Metric, source poolSource,
 ) *TransactionPoolCollector {
 	return &TransactionPoolCollector{
 		client:   client,
+		source:   source,
 		metricsC: metricsC,
 	}
 }
@@ -52,6 +53,7 @@ func (c *TransactionPoolCollector) Collect(ctx context.Context) error {
 	c.collectTransactionsOutputs()
 	c.collectTransactionsAgeDistribution()
 	c.collectWeirdCases()
+	c.collectStates()
 
 	return nil
 }
@@ -62,66 +64,47 @@ func (c *TransactionPoolCollector) fetchData(ctx context.Context) error {
 		return fmt.Errorf("get transactionpool stats: %w", err)
 	}
 
-	pool, err := c.client.GetTransactionPool(ctx)
+	view, err := c.source.View(ctx, c.client)
 	if err != nil {
-		return fmt.Errorf("get transaction pool: %w", err)
+		return fmt.Errorf("pool view: %w", err)
 	}
 
 	c.stats = stats
-	c.pool = pool
-
-	c.txns = make([]*daemon.TransactionJSON, len(pool.Transactions))
-
-	for idx, txn := range c.pool.Transactions {
-		c.txns[idx] = new(daemon.TransactionJSON)
-
-		err := json.Unmarshal([]byte(txn.TxJSON), c.txns[idx])
-		if err != nil {
-			return fmt.Errorf("unmarhsal tx json: %w", err)
-		}
-	}
+	c.view = view
+	c.txns = view.transactions
 
 	return nil
 }
 
 func (c *TransactionPoolCollector) collectSpentKeyImages() {
-	desc := prometheus.NewDesc(
-		"monero_transaction_pool_spent_key_images",
-		"total number of key images spent across all transactions"+
-			" in the pool",
-		nil, nil,
-	)
+	desc := metricTransactionPoolSpentKeyImages.Desc()
 
 	c.metricsC <- prometheus.MustNewConstMetric(
 		desc,
 		prometheus.GaugeValue,
-		float64(len(c.pool.SpentKeyImages)),
+		float64(c.view.spentKeyImages),
 	)
 
 }
 
 func (c *TransactionPoolCollector) collectTransactionsCount() {
-	desc := prometheus.NewDesc(
-		"monero_transaction_pool_transactions",
-		"number of transactions in the pool at the moment of "+
-			"the scrape",
-		nil, nil,
-	)
+	desc := metricTransactionPoolTransactions.Desc()
 
 	c.metricsC <- prometheus.MustNewConstMetric(
 		desc,
 		prometheus.GaugeValue,
-		float64(len(c.pool.Transactions)),
+		float64(c.view.total),
 	)
 
+	c.metricsC <- prometheus.MustNewConstMetric(
+		metricTransactionPoolUntrackedTransactions.Desc(),
+		prometheus.GaugeValue,
+		float64(c.view.Untracked()),
+	)
 }
 
 func (c *TransactionPoolCollector) collectSize() {
-	desc := prometheus.NewDesc(
-		"monero_transaction_pool_size_bytes",
-		"total size of the transaction pool",
-		nil, nil,
-	)
+	desc := metricTransactionPoolSizeBytes.Desc()
 
 	c.metricsC <- prometheus.MustNewConstMetric(
 		desc,
@@ -132,37 +115,27 @@ func (c *TransactionPoolCollector) collectSize() {
 
 func (c *TransactionPoolCollector) collectTransactionsSize() {
 	summary := NewSummary()
-	for _, txn := range c.pool.Transactions {
-		summary.Insert(float64(txn.BlobSize))
+	for _, txn := range c.txns {
+		summary.Insert(float64(txn.blobSize))
 	}
 
 	c.metricsC <- prometheus.MustNewConstSummary(
-		prometheus.NewDesc(
-			"monero_transaction_pool_transactions_size_bytes",
-			"distribution of the size of the transactions "+
-				"in the transaction pool",
-			nil, nil,
-		),
+		metricTransactionPoolTransactionsSizeBytes.Desc(),
 		summary.Count(), summary.Sum(), summary.Quantiles(),
 	)
 }
 
 func (c *TransactionPoolCollector) collectTransactionsFeePerKb() {
 	summary := NewSummary()
-	for _, txn := range c.pool.Transactions {
-		fee := float64(txn.Fee) / constant.MicroXMR
-		size := float64(txn.BlobSize) / 1024
+	for _, txn := range c.txns {
+		fee := float64(txn.fee) / constant.MicroXMR
+		size := float64(txn.blobSize) / 1024
 
 		summary.Insert(fee / size)
 	}
 
 	c.metricsC <- prometheus.MustNewConstSummary(
-		prometheus.NewDesc(
-			"monero_transaction_pool_fees_micronero_per_kb",
-			"distribution of the feeperkb utilized for txns"+
-				" in the pool",
-			nil, nil,
-		),
+		metricTransactionPoolFeesMicroneroPerKB.Desc(),
 		summary.Count(), summary.Sum(), summary.Quantiles(),
 	)
 }
@@ -170,15 +143,11 @@ func (c *TransactionPoolCollector) collectTransactionsFeePerKb() {
 func (c *TransactionPoolCollector) collectTransactionsInputs() {
 	summary := NewSummary()
 	for _, txn := range c.txns {
-		summary.Insert(float64(len(txn.Vin)))
+		summary.Insert(float64(txn.inputs))
 	}
 
 	c.metricsC <- prometheus.MustNewConstSummary(
-		prometheus.NewDesc(
-			"monero_transaction_pool_transactions_inputs",
-			"distribution of inputs in the pool",
-			nil, nil,
-		),
+		metricTransactionPoolTransactionsInputs.Desc(),
 		summary.Count(), summary.Sum(), summary.Quantiles(),
 	)
 }
@@ -186,15 +155,11 @@ func (c *TransactionPoolCollector) collectTransactionsInputs() {
 func (c *TransactionPoolCollector) collectTransactionsOutputs() {
 	summary := NewSummary()
 	for _, txn := range c.txns {
-		summary.Insert(float64(len(txn.Vout)))
+		summary.Insert(float64(txn.outputs))
 	}
 
 	c.metricsC <- prometheus.MustNewConstSummary(
-		prometheus.NewDesc(
-			"monero_transaction_pool_transactions_outputs",
-			"distribution of outputs in the pool",
-			nil, nil,
-		),
+		metricTransactionPoolTransactionsOutputs.Desc(),
 		summary.Count(), summary.Sum(), summary.Quantiles(),
 	)
 }
@@ -203,29 +168,20 @@ func (c *TransactionPoolCollector) collectTransactionsAgeDistribution() {
 	now := time.Now()
 
 	summary := NewSummary()
-	for _, txn := range c.pool.Transactions {
+	for _, txn := range c.txns {
 		summary.Insert(
-			now.Sub(time.Unix(txn.ReceiveTime, 0)).Seconds(),
+			now.Sub(time.Unix(txn.receiveTime, 0)).Seconds(),
 		)
 	}
 
 	c.metricsC <- prometheus.MustNewConstSummary(
-		prometheus.NewDesc(
-			"monero_transaction_pool_transactions_age",
-			"distribution of for how long transactions have "+
-				"been in the pool",
-			nil, nil,
-		),
+		metricTransactionPoolTransactionsAge.Desc(),
 		summary.Count(), summary.Sum(), summary.Quantiles(),
 	)
 }
 
 func (c *TransactionPoolCollector) collectTransactionsFee() {
-	desc := prometheus.NewDesc(
-		"monero_transaction_pool_fees_monero",
-		"total amount of fee being spent in the transaction pool",
-		nil, nil,
-	)
+	desc := metricTransactionPoolFeesMonero.Desc()
 
 	c.metricsC <- prometheus.MustNewConstMetric(
 		desc,
@@ -236,42 +192,89 @@ func (c *TransactionPoolCollector) collectTransactionsFee() {
 
 func (c *TransactionPoolCollector) collectWeirdCases() {
 	c.metricsC <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			"monero_transaction_pool_failing_transactions",
-			"number of transactions that are marked as failing",
-			nil, nil,
-		),
+		metricTransactionPoolFailingTransactions.Desc(),
 		prometheus.GaugeValue,
 		float64(c.stats.PoolStats.NumFailing),
 	)
 
 	c.metricsC <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			"monero_transaction_pool_double_spends",
-			"transactions doubly spending outputs",
-			nil, nil,
-		),
+		metricTransactionPoolDoubleSpends.Desc(),
 		prometheus.GaugeValue,
 		float64(c.stats.PoolStats.NumDoubleSpends),
 	)
 
 	c.metricsC <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			"monero_transaction_pool_not_relayed",
-			"number of transactions that have not been relayed",
-			nil, nil,
-		),
+		metricTransactionPoolNotRelayed.Desc(),
 		prometheus.GaugeValue,
 		float64(c.stats.PoolStats.NumNotRelayed),
 	)
 
 	c.metricsC <- prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			"monero_transaction_pool_older_than_10m",
-			"number of transactions that are older than 10m",
-			nil, nil,
-		),
+		metricTransactionPoolOlderThan10m.Desc(),
 		prometheus.GaugeValue,
 		float64(c.stats.PoolStats.Num10M),
 	)
 }
+
+// collectStates reports how many transactions (and how big, how much in fees
+// and for how long) are in each of the states that transactions in the pool
+// can be in.
+//
+// ps.: transactions may be in more than one state at once (e.g., relayed and
+// double spending), thus, states don't add up to the whole pool.
+//
+// ps.: nothing is reported when the states aren't known (see `poolCache`),
+// rather than presenting them as empty.
+//
+func (c *TransactionPoolCollector) collectStates() {
+	if !c.view.statesKnown {
+		return
+	}
+
+	now := time.Now()
+
+	for _, state := range poolStates {
+		count, size, fees := 0, uint64(0), uint64(0)
+		age := NewSummary()
+
+		for _, txn := range c.txns {
+			if !state.in(txn) {
+				continue
+			}
+
+			count++
+			size += txn.blobSize
+			fees += txn.fee
+			age.Insert(
+				now.Sub(time.Unix(txn.receiveTime, 0)).Seconds(),
+			)
+		}
+
+		c.metricsC <- prometheus.MustNewConstMetric(
+			metricTransactionPoolStateTransactions.Desc(),
+			prometheus.GaugeValue,
+			float64(count),
+			state.name,
+		)
+
+		c.metricsC <- prometheus.MustNewConstMetric(
+			metricTransactionPoolStateSizeBytes.Desc(),
+			prometheus.GaugeValue,
+			float64(size),
+			state.name,
+		)
+
+		c.metricsC <- prometheus.MustNewConstMetric(
+			metricTransactionPoolStateFeesMonero.Desc(),
+			prometheus.GaugeValue,
+			float64(fees)/constant.XMR,
+			state.name,
+		)
+
+		c.metricsC <- prometheus.MustNewConstSummary(
+			metricTransactionPoolStateAge.Desc(),
+			age.Count(), age.Sum(), age.Quantiles(),
+			state.name,
+		)
+	}
+}
@@ -5,4 +5,9 @@
 // particular interval defined in this exporter (instead, rely on prometheus'
 // scrape interval).
 //
+// Optionally, collection can take place in the background at a fixed interval
+// (see `WithPollInterval`), with scrapes being served the last snapshot of the
+// metrics, bounding the load on monerod regardless of how often (or by how
+// many) it gets scraped.
+//
 package collector
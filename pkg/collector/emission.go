@@ -0,0 +1,147 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+)
+
+const (
+	// emissionSafeDepth is the number of blocks (counting from the tip)
+	// that are summed on every update rather than cached, as they may
+	// still be reorganized.
+	//
+	emissionSafeDepth = 10
+
+	// emissionChunkSize is the maximum number of blocks summed by a
+	// single `get_coinbase_tx_sum` request while catching up with the
+	// chain.
+	//
+	emissionChunkSize = 10000
+)
+
+// emissionTracker keeps the running sum of the coins emitted and fees paid
+// across the whole chain, so that only blocks that are new since the last
+// update need to be summed.
+//
+// Catching up with a long chain (e.g., on the first update) takes as many
+// requests as chunks of `emissionChunkSize` blocks - if the update gets cut
+// short (e.g., by a timeout), the progress made is kept for the next one.
+//
+type emissionTracker struct {
+	mu sync.Mutex
+
+	// height is the number of blocks (from the genesis onwards) that
+	// `emission` and `fees` account for.
+	//
+	height   uint64
+	emission *big.Int
+	fees     *big.Int
+}
+
+func newEmissionTracker() *emissionTracker {
+	return &emissionTracker{
+		emission: new(big.Int),
+		fees:     new(big.Int),
+	}
+}
+
+// Update sums the coins emitted and fees paid (in atomic units) by the first
+// `height` blocks of the chain.
+//
+func (t *emissionTracker) Update(
+	ctx context.Context, client Client, height uint64,
+) (emission, fees *big.Int, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	safeHeight := uint64(0)
+	if height > emissionSafeDepth {
+		safeHeight = height - emissionSafeDepth
+	}
+
+	for t.height < safeHeight {
+		count := safeHeight - t.height
+		if count > emissionChunkSize {
+			count = emissionChunkSize
+		}
+
+		chunkEmission, chunkFees, err := coinbaseSum(
+			ctx, client, t.height, count,
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("coinbase sum: %w", err)
+		}
+
+		t.emission.Add(t.emission, chunkEmission)
+		t.fees.Add(t.fees, chunkFees)
+		t.height += count
+	}
+
+	emission = new(big.Int).Set(t.emission)
+	fees = new(big.Int).Set(t.fees)
+
+	if height > t.height {
+		tipEmission, tipFees, err := coinbaseSum(
+			ctx, client, t.height, height-t.height,
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("coinbase sum: %w", err)
+		}
+
+		emission.Add(emission, tipEmission)
+		fees.Add(fees, tipFees)
+	}
+
+	return emission, fees, nil
+}
+
+// coinbaseSum retrieves the coins emitted and fees paid by `count` blocks
+// starting at `height`.
+//
+func coinbaseSum(
+	ctx context.Context, client Client, height, count uint64,
+) (emission, fees *big.Int, err error) {
+	resp, err := client.GetCoinbaseTxSum(ctx, height, count)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get coinbase tx sum: %w", err)
+	}
+
+	emission, err = wideAmount(resp.WideEmissionAmount,
+		uint64(resp.EmissionAmountTop64), uint64(resp.EmissionAmount))
+	if err != nil {
+		return nil, nil, fmt.Errorf("emission amount: %w", err)
+	}
+
+	fees, err = wideAmount(resp.WideFeeAmount,
+		uint64(resp.FeeAmountTop64), uint64(resp.FeeAmount))
+	if err != nil {
+		return nil, nil, fmt.Errorf("fee amount: %w", err)
+	}
+
+	return emission, fees, nil
+}
+
+// wideAmount parses a 128-bit amount, preferably from its hexadecimal form
+// (e.g., `0x1a2b`), falling back to its most and least significant 64 bits.
+//
+func wideAmount(wide string, top64, low64 uint64) (*big.Int, error) {
+	if wide != "" {
+		amount, ok := new(big.Int).SetString(
+			strings.TrimPrefix(wide, "0x"), 16,
+		)
+		if !ok {
+			return nil, fmt.Errorf("invalid amount '%s'", wide)
+		}
+
+		return amount, nil
+	}
+
+	amount := new(big.Int).SetUint64(top64)
+	amount.Lsh(amount, 64)
+	amount.Add(amount, new(big.Int).SetUint64(low64))
+
+	return amount, nil
+}
@@ -0,0 +1,117 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+)
+
+// feeEstimateGraceBlocks is the number of blocks that fee estimates are
+// requested to remain valid for - the same as wallets do.
+//
+const feeEstimateGraceBlocks = 10
+
+// feeTierNone is the tier of fees that fall short of every priority tier.
+//
+const feeTierNone = "none"
+
+// feeTierNames are the names of the priorities that wallets let users pick
+// from, in the order that `get_fee_estimate` reports the fees for them.
+//
+var feeTierNames = []string{"unimportant", "normal", "elevated", "priority"}
+
+// feeTier is a priority along with the fee (per byte) recommended for it.
+//
+type feeTier struct {
+	name       string
+	feePerByte uint64
+}
+
+// feeEstimate is the result of `get_fee_estimate`.
+//
+// ps.: `daemon.GetFeeEstimateResult` lacks the fees for each of the
+// priorities (`fees`), thus why we bring our own.
+//
+type feeEstimate struct {
+	// Fee is the base fee per byte.
+	//
+	Fee uint64 `json:"fee"`
+
+	// Fees are the fees per byte for each of the priorities, from the
+	// lowest to the highest.
+	//
+	Fees []uint64 `json:"fees"`
+
+	// QuantizationMask is the amount that fees should be rounded up to
+	// a multiple of.
+	//
+	QuantizationMask uint64 `json:"quantization_mask"`
+}
+
+func getFeeEstimate(ctx context.Context, client Client) (*feeEstimate, error) {
+	resp := &feeEstimate{}
+	params := map[string]uint64{
+		"grace_blocks": feeEstimateGraceBlocks,
+	}
+
+	err := client.JSONRPC(ctx, "get_fee_estimate", params, resp)
+	if err != nil {
+		return nil, fmt.Errorf("jsonrpc get_fee_estimate: %w", err)
+	}
+
+	return resp, nil
+}
+
+// Tiers retrieves the fee recommended for each of the priorities, lowest
+// first.
+//
+// ps.: nodes that predate per-priority estimates only report the base fee,
+// which is what the lowest priority pays.
+//
+func (e *feeEstimate) Tiers() []feeTier {
+	if len(e.Fees) == 0 {
+		return []feeTier{{name: feeTierNames[0], feePerByte: e.Fee}}
+	}
+
+	tiers := make([]feeTier, 0, len(e.Fees))
+	for idx, fee := range e.Fees {
+		name := fmt.Sprintf("tier%d", idx+1)
+		if idx < len(feeTierNames) {
+			name = feeTierNames[idx]
+		}
+
+		tiers = append(tiers, feeTier{name: name, feePerByte: fee})
+	}
+
+	return tiers
+}
+
+// tierOf retrieves the name of the highest tier whose fee `feePerByte` meets,
+// or `feeTierNone` if it falls short of all of them.
+//
+func tierOf(tiers []feeTier, feePerByte float64) string {
+	name := feeTierNone
+	for _, tier := range tiers {
+		if feePerByte < float64(tier.feePerByte) {
+			break
+		}
+
+		name = tier.name
+	}
+
+	return name
+}
+
+// feePerByte computes the fee paid per byte of transaction weight (what fee
+// estimates are based on), falling back to the size of its blob.
+//
+func feePerByte(fee, weight, blobSize uint64) float64 {
+	if weight == 0 {
+		weight = blobSize
+	}
+
+	if weight == 0 {
+		return 0
+	}
+
+	return float64(fee) / float64(weight)
+}
@@ -0,0 +1,256 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cirocosta/go-monero/pkg/rpc/daemon"
+)
+
+const (
+	// inclusionTrackerSize is the maximum number of transactions that the
+	// inclusion tracker keeps track of at once - past that, transactions
+	// showing up in the pool are ignored until room is made.
+	//
+	inclusionTrackerSize = 50000
+
+	// inclusionTrackerMaxBlocks is the maximum number of blocks looked
+	// into for transactions being mined on each observation. Blocks
+	// further away from the tip (e.g., after the exporter lost track of
+	// the node for a while) are skipped, with the transactions that left
+	// the pool in the meantime being forgotten (as whether they got mined
+	// can't be told).
+	//
+	inclusionTrackerMaxBlocks = 10
+)
+
+// poolSighting is a transaction that we've seen in the pool.
+//
+type poolSighting struct {
+	// firstSeen is when the transaction entered the pool, as told by
+	// monerod (or, if it doesn't, when we first saw it).
+	//
+	firstSeen time.Time
+	tier      string
+
+	// missing indicates that the transaction was no longer in the pool
+	// as of the last observation, without having been mined (yet).
+	//
+	missing bool
+}
+
+// inclusionTracker remembers when transactions entered the pool so that,
+// once they get mined, the time that they had to wait for it can be
+// measured.
+//
+// ps.: as whether they got mined is only noticed on our own observations,
+// measurements are only as precise as the interval between them.
+//
+type inclusionTracker struct {
+	size int
+
+	mu         sync.Mutex
+	seen       map[string]*poolSighting
+	lastHeight uint64
+	lastHash   string
+
+	delay   *prometheus.HistogramVec
+	dropped prometheus.Counter
+}
+
+func newInclusionTracker(size int) *inclusionTracker {
+	t := &inclusionTracker{
+		size: size,
+		seen: map[string]*poolSighting{},
+		delay: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: metricTransactionInclusionDelaySeconds.Name,
+			Help: metricTransactionInclusionDelaySeconds.Help,
+			Buckets: []float64{
+				60, 120, 300, 600, 1200, 1800,
+				3600, 7200, 14400, 43200, 86400,
+			},
+		}, metricTransactionInclusionDelaySeconds.Labels),
+		dropped: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: metricTransactionPoolDroppedTotal.Name,
+			Help: metricTransactionPoolDroppedTotal.Help,
+		}),
+	}
+
+	// have every tier show up from the start, even if empty.
+	//
+	t.delay.WithLabelValues(feeTierNone)
+	for _, tier := range feeTierNames {
+		t.delay.WithLabelValues(tier)
+	}
+
+	return t
+}
+
+// Observe looks at the transactions in the pool and at the blocks mined since
+// the last observation, accounting for the transactions that got mined (or
+// dropped) in the meantime.
+//
+// ps.: a transaction that leaves the pool without showing up in a block is
+// only deemed dropped if it still hasn't shown up in one by the following
+// observation, as the pool and the tip may not be retrieved at the exact
+// same point in time. For the same reason, a transaction showing up in a
+// block is not tracked even if it's still in the pool that was retrieved.
+//
+func (t *inclusionTracker) Observe(
+	ctx context.Context, client Client, source poolSource,
+) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	view, err := source.View(ctx, client)
+	if err != nil {
+		return fmt.Errorf("pool view: %w", err)
+	}
+
+	estimate, err := getFeeEstimate(ctx, client)
+	if err != nil {
+		return fmt.Errorf("get fee estimate: %w", err)
+	}
+
+	mined, complete, err := t.minedSince(ctx, client)
+	if err != nil {
+		return fmt.Errorf("mined since: %w", err)
+	}
+
+	now := time.Now()
+
+	minedSet := make(map[string]struct{}, len(mined))
+	for _, hash := range mined {
+		minedSet[hash] = struct{}{}
+
+		sighting, found := t.seen[hash]
+		if !found {
+			continue
+		}
+
+		t.delay.WithLabelValues(sighting.tier).Observe(
+			now.Sub(sighting.firstSeen).Seconds(),
+		)
+		delete(t.seen, hash)
+	}
+
+	inPool := make(map[string]struct{}, len(view.transactions))
+	tiers := estimate.Tiers()
+
+	for _, txn := range view.transactions {
+		inPool[txn.hash] = struct{}{}
+
+		if sighting, found := t.seen[txn.hash]; found {
+			sighting.missing = false
+			continue
+		}
+
+		if _, found := minedSet[txn.hash]; found {
+			continue
+		}
+
+		if len(t.seen) >= t.size {
+			continue
+		}
+
+		firstSeen := now
+		if txn.receiveTime != 0 {
+			firstSeen = time.Unix(txn.receiveTime, 0)
+		}
+
+		t.seen[txn.hash] = &poolSighting{
+			firstSeen: firstSeen,
+			tier:      tierOf(tiers, txn.FeePerByte()),
+		}
+	}
+
+	for hash, sighting := range t.seen {
+		if _, found := inPool[hash]; found {
+			continue
+		}
+
+		if !complete {
+			delete(t.seen, hash)
+			continue
+		}
+
+		if sighting.missing {
+			t.dropped.Inc()
+			delete(t.seen, hash)
+			continue
+		}
+
+		sighting.missing = true
+	}
+
+	return nil
+}
+
+// minedSince retrieves the hashes of the transactions included in the
+// blocks mined since the last observation (none on the first one), telling
+// whether all of those blocks were looked into (see
+// `inclusionTrackerMaxBlocks`).
+//
+func (t *inclusionTracker) minedSince(
+	ctx context.Context, client Client,
+) ([]string, bool, error) {
+	resp, err := client.GetLastBlockHeader(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("get last block header: %w", err)
+	}
+
+	tip := resp.BlockHeader
+
+	if t.lastHash == "" || tip.Hash == t.lastHash {
+		t.lastHeight, t.lastHash = tip.Height, tip.Hash
+		return nil, true, nil
+	}
+
+	// on a reorganization (the tip not being higher than the last one),
+	// the tip itself is looked into.
+	//
+	start := t.lastHeight + 1
+	if start > tip.Height {
+		start = tip.Height
+	}
+
+	complete := true
+	if tip.Height-start+1 > inclusionTrackerMaxBlocks {
+		start = tip.Height + 1 - inclusionTrackerMaxBlocks
+		complete = false
+	}
+
+	headersResp, err := client.GetBlockHeadersRange(ctx, start, tip.Height)
+	if err != nil {
+		return nil, false, fmt.Errorf("get block headers range: %w", err)
+	}
+
+	hashes := []string{}
+	for _, header := range headersResp.Headers {
+		if header.NumTxes == 0 {
+			continue
+		}
+
+		blockResp, err := client.GetBlock(ctx,
+			daemon.GetBlockRequestParameters{Hash: header.Hash})
+		if err != nil {
+			return nil, false, fmt.Errorf("get block '%s': %w",
+				header.Hash, err)
+		}
+
+		blockJSON, err := blockResp.InnerJSON()
+		if err != nil {
+			return nil, false, fmt.Errorf("block inner json: %w", err)
+		}
+
+		hashes = append(hashes, blockJSON.TxHashes...)
+	}
+
+	t.lastHeight, t.lastHash = tip.Height, tip.Hash
+
+	return hashes, complete, nil
+}
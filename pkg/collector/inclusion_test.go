@@ -0,0 +1,205 @@
+package collector_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cirocosta/monero-exporter/pkg/collector"
+	"github.com/cirocosta/monero-exporter/pkg/fakemonerod"
+)
+
+var (
+	// txnF and txnE are the transactions in the pool served by default,
+	// both paying for the `unimportant` tier.
+	//
+	txnF = strings.Repeat("f", 64)
+	txnE = strings.Repeat("e", 64)
+
+	// txnReceiveTime is when the transactions in the pool were received.
+	//
+	txnReceiveTime = time.Unix(1640000000, 0)
+)
+
+// setPool has the fake monerod serve a pool with the transactions (out of
+// `txnF` and `txnE`) in `hashes`.
+//
+func setPool(t *testing.T, server *fakemonerod.Server, hashes ...string) {
+	t.Helper()
+
+	fees := map[string]uint64{txnF: 30000000, txnE: 60000000}
+	weights := map[string]uint64{txnF: 1500, txnE: 2500}
+
+	txns := []map[string]interface{}{}
+	for _, hash := range hashes {
+		txns = append(txns, map[string]interface{}{
+			"id_hash":      hash,
+			"blob_size":    weights[hash],
+			"weight":       weights[hash],
+			"fee":          fees[hash],
+			"receive_time": txnReceiveTime.Unix(),
+			"relayed":      true,
+			"tx_json":      "{}",
+		})
+	}
+
+	err := server.SetResponse("/get_transaction_pool", map[string]interface{}{
+		"status":       "OK",
+		"transactions": txns,
+	})
+	if err != nil {
+		t.Fatalf("set response: %v", err)
+	}
+}
+
+// TestInclusion drives the chain and the pool through a series of changes,
+// checking how the transactions leaving the pool are accounted for after
+// each observation.
+//
+func TestInclusion(t *testing.T) {
+	genesis := testBlock{hash: "genesis"}
+	initial := chainOf(genesis, "a", 100, 104)
+
+	mined := func(txs ...string) []testBlock {
+		chain := chainOf(genesis, "a", 100, 105)
+		chain[len(chain)-1].txs = txs
+
+		return chain
+	}
+
+	type step struct {
+		chain   []testBlock
+		pool    []string
+		delays  uint64
+		dropped float64
+	}
+
+	for _, tc := range []struct {
+		desc  string
+		steps []step
+	}{
+		{
+			desc: "mined",
+			steps: []step{
+				{chain: mined(txnF), pool: []string{txnE},
+					delays: 1},
+				{chain: mined(txnF), pool: []string{txnE},
+					delays: 1},
+			},
+		},
+		{
+			desc: "mined while still in the pool",
+			steps: []step{
+				{chain: mined(txnF), pool: []string{txnF, txnE},
+					delays: 1},
+				{chain: mined(txnF), pool: []string{txnE},
+					delays: 1},
+				{chain: mined(txnF), pool: []string{txnE},
+					delays: 1},
+			},
+		},
+		{
+			desc: "dropped",
+			steps: []step{
+				{chain: initial, pool: []string{txnE}},
+				{chain: initial, pool: []string{txnE},
+					dropped: 1},
+			},
+		},
+		{
+			desc: "mined late",
+			steps: []step{
+				{chain: initial, pool: []string{txnE}},
+				{chain: mined(txnF), pool: []string{txnE},
+					delays: 1},
+				{chain: mined(txnF), pool: []string{txnE},
+					delays: 1},
+			},
+		},
+		{
+			desc: "left while too many blocks were mined",
+			steps: []step{
+				{chain: chainOf(genesis, "a", 100, 120),
+					pool: []string{txnE}},
+				{chain: chainOf(genesis, "a", 100, 120),
+					pool: []string{txnE}},
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			server := newTestServer(t)
+			setChain(t, server, initial)
+			setPool(t, server, txnF, txnE)
+
+			c := newServerCollector(t, server,
+				collector.WithCollectors("inclusion"),
+			)
+
+			gather(t, c)
+
+			for idx, step := range tc.steps {
+				setChain(t, server, step.chain)
+				setPool(t, server, step.pool...)
+
+				families := gather(t, c)
+
+				delays, _ := sample(families,
+					"monero_transaction_inclusion_delay_seconds",
+					"tier", "unimportant")
+				if uint64(delays) != step.delays {
+					t.Errorf("step %d: expected %d delays, "+
+						"got %v", idx, step.delays, delays)
+				}
+
+				dropped, _ := sample(families,
+					"monero_transaction_pool_dropped_total")
+				if dropped != step.dropped {
+					t.Errorf("step %d: expected %v dropped, "+
+						"got %v", idx, step.dropped, dropped)
+				}
+			}
+		})
+	}
+}
+
+// TestInclusionDelaySinceReceived checks that delays are measured from when
+// monerod received the transaction rather than from when it was first seen
+// by the exporter.
+//
+func TestInclusionDelaySinceReceived(t *testing.T) {
+	genesis := testBlock{hash: "genesis"}
+
+	server := newTestServer(t)
+	setChain(t, server, chainOf(genesis, "a", 100, 104))
+	setPool(t, server, txnF, txnE)
+
+	c := newServerCollector(t, server,
+		collector.WithCollectors("inclusion"),
+	)
+
+	gather(t, c)
+
+	chain := chainOf(genesis, "a", 100, 105)
+	chain[len(chain)-1].txs = []string{txnF}
+
+	setChain(t, server, chain)
+	setPool(t, server, txnE)
+
+	families := gather(t, c)
+
+	// only the `+Inf` bucket holds delays longer than a day.
+	//
+	within, _ := bucket(families,
+		"monero_transaction_inclusion_delay_seconds", 86400,
+		"tier", "unimportant")
+	total, _ := sample(families,
+		"monero_transaction_inclusion_delay_seconds",
+		"tier", "unimportant")
+
+	if within != 0 || total != 1 {
+		t.Errorf("expected a single delay longer than a day (since %s), "+
+			"got %d out of %v within a day", txnReceiveTime, within, total)
+	}
+}
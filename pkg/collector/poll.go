@@ -0,0 +1,76 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// WithPollInterval is a functional argument that makes the collectors run in
+// the background at every interval (see `Run`) rather than on every scrape,
+// with scrapes being served the metrics gathered in the last refresh.
+//
+func WithPollInterval(v time.Duration) func(c *Collector) {
+	return func(c *Collector) {
+		c.pollInterval = v
+	}
+}
+
+// Run refreshes the metrics of every collector at the interval configured
+// via `WithPollInterval`, making it possible for multiple scrapes to be
+// served from the same snapshot without multiplying the load on monerod.
+//
+// ps.: this is a BLOCKING method that only returns once `ctx` is done - make
+// sure you either make use of goroutines to not block if needed.
+//
+func (c *Collector) Run(ctx context.Context) error {
+	if c.pollInterval <= 0 {
+		return fmt.Errorf("poll interval not configured")
+	}
+
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		c.refresh()
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return fmt.Errorf("ctx err: %w", ctx.Err())
+		}
+	}
+}
+
+// refresh runs all of the collectors, keeping their results around to be
+// served on scrapes.
+//
+// ps.: for a collector that failed, the metrics from its last successful run
+// are kept.
+//
+func (c *Collector) refresh() {
+	results := c.runCollectors(context.Background())
+
+	c.resultsMu.Lock()
+	defer c.resultsMu.Unlock()
+
+	for idx := range results {
+		if results[idx].success || idx >= len(c.results) {
+			continue
+		}
+
+		results[idx].metrics = c.results[idx].metrics
+		results[idx].refreshed = c.results[idx].refreshed
+	}
+
+	c.results = results
+}
+
+// lastResults retrieves the results of the last refresh.
+//
+func (c *Collector) lastResults() []collectorResult {
+	c.resultsMu.RLock()
+	defer c.resultsMu.RUnlock()
+
+	return c.results
+}
@@ -0,0 +1,354 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/cirocosta/go-monero/pkg/rpc/daemon"
+)
+
+const (
+	// defaultPoolCacheSize is the default maximum number of transactions
+	// kept by the incremental view of the pool.
+	//
+	defaultPoolCacheSize = 20000
+
+	// poolCacheBatchSize is the maximum number of transactions retrieved
+	// by a single `get_transactions` request (the most that monerod
+	// serves on restricted RPC ports).
+	//
+	poolCacheBatchSize = 100
+)
+
+// poolTransaction is a transaction in the pool, carrying just what's looked
+// at by the collectors, regardless of how it's been retrieved.
+//
+type poolTransaction struct {
+	hash        string
+	blobSize    uint64
+	weight      uint64
+	fee         uint64
+	receiveTime int64
+	inputs      int
+	outputs     int
+	keyImages   []string
+
+	relayed          bool
+	doNotRelay       bool
+	keptByBlock      bool
+	doubleSpendSeen  bool
+	lastFailedHeight uint64
+}
+
+// FeePerByte is the fee paid per byte of weight.
+//
+func (t *poolTransaction) FeePerByte() float64 {
+	return feePerByte(t.fee, t.weight, t.blobSize)
+}
+
+// poolStates are the states that transactions in the pool can be in (not
+// mutually exclusive), along with how to tell whether a transaction is in
+// each of them.
+//
+var poolStates = []struct {
+	name string
+	in   func(t *poolTransaction) bool
+}{
+	{"relayed", func(t *poolTransaction) bool {
+		return t.relayed
+	}},
+	{"do_not_relay", func(t *poolTransaction) bool {
+		return t.doNotRelay
+	}},
+	{"kept_by_block", func(t *poolTransaction) bool {
+		return t.keptByBlock
+	}},
+	{"double_spend_seen", func(t *poolTransaction) bool {
+		return t.doubleSpendSeen
+	}},
+	{"failed", func(t *poolTransaction) bool {
+		return t.lastFailedHeight != 0
+	}},
+}
+
+// poolView is what a poolSource tells about the pool.
+//
+type poolView struct {
+	// transactions are the transactions in the pool that the source
+	// keeps track of, which, for bounded sources, may not be all of them.
+	//
+	transactions []*poolTransaction
+
+	// total is the number of transactions in the pool, including those
+	// not being kept track of.
+	//
+	total int
+
+	// spentKeyImages is the number of key images spent across the pool.
+	//
+	spentKeyImages int
+
+	// statesKnown indicates whether the states of the transactions (see
+	// `poolStates`) are known, and up to date.
+	//
+	statesKnown bool
+}
+
+// Untracked is the number of transactions in the pool that the source isn't
+// keeping track of.
+//
+func (v *poolView) Untracked() int {
+	return v.total - len(v.transactions)
+}
+
+// poolSource retrieves the transactions currently in the pool.
+//
+type poolSource interface {
+	View(ctx context.Context, client Client) (*poolView, error)
+}
+
+// fullPool is a poolSource that retrieves the whole pool (transactions
+// included) through `get_transaction_pool` every time.
+//
+type fullPool struct{}
+
+var _ poolSource = fullPool{}
+
+// View implements poolSource.
+//
+func (fullPool) View(
+	ctx context.Context, client Client,
+) (*poolView, error) {
+	pool, err := client.GetTransactionPool(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get transaction pool: %w", err)
+	}
+
+	txns := make([]*poolTransaction, len(pool.Transactions))
+	for idx, txn := range pool.Transactions {
+		txJSON := &daemon.TransactionJSON{}
+
+		err := json.Unmarshal([]byte(txn.TxJSON), txJSON)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal tx json: %w", err)
+		}
+
+		txns[idx] = &poolTransaction{
+			hash:             txn.IDHash,
+			blobSize:         txn.BlobSize,
+			weight:           txn.Weight,
+			fee:              txn.Fee,
+			receiveTime:      txn.ReceiveTime,
+			inputs:           len(txJSON.Vin),
+			outputs:          len(txJSON.Vout),
+			keyImages:        keyImages(txJSON),
+			relayed:          txn.Relayed,
+			doNotRelay:       txn.DoNotRelay,
+			keptByBlock:      txn.KeptByBlock,
+			doubleSpendSeen:  txn.DoubleSpendSeen,
+			lastFailedHeight: txn.LastFailedHeight,
+		}
+	}
+
+	return &poolView{
+		transactions:   txns,
+		total:          len(txns),
+		spentKeyImages: len(pool.SpentKeyImages),
+		statesKnown:    true,
+	}, nil
+}
+
+// poolCache is a poolSource that keeps a view of the pool across
+// collections, only retrieving (through `get_transactions`) the transactions
+// that entered the pool since the last time it was looked at.
+//
+// The view is bounded to `size` transactions, leaving out any that enter
+// the pool past that (still accounted for in the total), with the key images
+// spent being counted only across those in the view.
+//
+// ps.: as `get_transactions` doesn't tell about the weight of transactions,
+// the blob size stands for it. Their states are left unknown, as it doesn't
+// tell about most of them either, and, with transactions being retrieved
+// only once, those that it does tell about would not be kept up to date.
+//
+type poolCache struct {
+	size int
+
+	mu   sync.Mutex
+	txns map[string]*poolTransaction
+}
+
+var _ poolSource = (*poolCache)(nil)
+
+func newPoolCache(size int) *poolCache {
+	return &poolCache{
+		size: size,
+		txns: map[string]*poolTransaction{},
+	}
+}
+
+// View implements poolSource.
+//
+func (p *poolCache) View(
+	ctx context.Context, client Client,
+) (*poolView, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	hashes, err := getTransactionPoolHashes(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("get transaction pool hashes: %w", err)
+	}
+
+	inPool := make(map[string]struct{}, len(hashes))
+	for _, hash := range hashes {
+		inPool[hash] = struct{}{}
+	}
+
+	for hash := range p.txns {
+		if _, found := inPool[hash]; !found {
+			delete(p.txns, hash)
+		}
+	}
+
+	missing := []string{}
+	for _, hash := range hashes {
+		if len(p.txns)+len(missing) >= p.size {
+			break
+		}
+
+		if _, found := p.txns[hash]; !found {
+			missing = append(missing, hash)
+		}
+	}
+
+	for start := 0; start < len(missing); start += poolCacheBatchSize {
+		end := start + poolCacheBatchSize
+		if end > len(missing) {
+			end = len(missing)
+		}
+
+		txns, err := getPoolTransactions(ctx, client, missing[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("get pool transactions: %w", err)
+		}
+
+		for _, txn := range txns {
+			p.txns[txn.hash] = txn
+		}
+	}
+
+	txns := make([]*poolTransaction, 0, len(p.txns))
+	keyImages := map[string]struct{}{}
+	for _, hash := range hashes {
+		txn, found := p.txns[hash]
+		if !found {
+			continue
+		}
+
+		txns = append(txns, txn)
+		for _, keyImage := range txn.keyImages {
+			keyImages[keyImage] = struct{}{}
+		}
+	}
+
+	return &poolView{
+		transactions:   txns,
+		total:          len(hashes),
+		spentKeyImages: len(keyImages),
+	}, nil
+}
+
+// getTransactionPoolHashes retrieves the hashes of the transactions in the
+// pool.
+//
+func getTransactionPoolHashes(
+	ctx context.Context, client Client,
+) ([]string, error) {
+	resp := struct {
+		TxHashes []string `json:"tx_hashes"`
+	}{}
+
+	err := client.RawRequest(ctx, "/get_transaction_pool_hashes", nil, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("raw request: %w", err)
+	}
+
+	return resp.TxHashes, nil
+}
+
+// getPoolTransactions retrieves the transactions from the pool identified by
+// `hashes`, leaving out those that are no longer in it.
+//
+// ps.: `daemon.GetTransactionsResult` lacks the fields that monerod only
+// fills for transactions in the pool (e.g., `received_timestamp`), thus why
+// we bring our own.
+//
+func getPoolTransactions(
+	ctx context.Context, client Client, hashes []string,
+) ([]*poolTransaction, error) {
+	resp := struct {
+		Txs []struct {
+			AsHex             string `json:"as_hex"`
+			AsJSON            string `json:"as_json"`
+			InPool            bool   `json:"in_pool"`
+			ReceivedTimestamp int64  `json:"received_timestamp"`
+			TxHash            string `json:"tx_hash"`
+		} `json:"txs"`
+	}{}
+
+	params := map[string]interface{}{
+		"txs_hashes":     hashes,
+		"decode_as_json": true,
+	}
+
+	err := client.RawRequest(ctx, "/get_transactions", params, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("raw request: %w", err)
+	}
+
+	txns := make([]*poolTransaction, 0, len(resp.Txs))
+	for _, txn := range resp.Txs {
+		if !txn.InPool {
+			continue
+		}
+
+		txJSON := &daemon.TransactionJSON{}
+
+		err := json.Unmarshal([]byte(txn.AsJSON), txJSON)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal txn '%s': %w",
+				txn.TxHash, err)
+		}
+
+		size := uint64(len(txn.AsHex) / 2)
+
+		txns = append(txns, &poolTransaction{
+			hash:        txn.TxHash,
+			blobSize:    size,
+			weight:      size,
+			fee:         txJSON.RctSignatures.Txnfee,
+			receiveTime: txn.ReceivedTimestamp,
+			inputs:      len(txJSON.Vin),
+			outputs:     len(txJSON.Vout),
+			keyImages:   keyImages(txJSON),
+		})
+	}
+
+	return txns, nil
+}
+
+// keyImages retrieves the key images spent by a transaction.
+//
+func keyImages(txn *daemon.TransactionJSON) []string {
+	images := make([]string, 0, len(txn.Vin))
+	for _, vin := range txn.Vin {
+		if vin.Key.KImage != "" {
+			images = append(images, vin.Key.KImage)
+		}
+	}
+
+	return images
+}
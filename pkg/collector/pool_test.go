@@ -0,0 +1,106 @@
+package collector_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cirocosta/monero-exporter/pkg/collector"
+	"github.com/cirocosta/monero-exporter/pkg/fakemonerod"
+)
+
+// poolHashes is the body of a `/get_transaction_pool_hashes` response
+// listing `hashes`.
+//
+func poolHashes(t *testing.T, hashes ...string) []byte {
+	t.Helper()
+
+	body, err := json.Marshal(map[string]interface{}{
+		"status":    "OK",
+		"tx_hashes": append([]string{}, hashes...),
+	})
+	if err != nil {
+		t.Fatalf("marshal pool hashes: %v", err)
+	}
+
+	return body
+}
+
+// TestPoolIncremental checks that, with the pool being looked at
+// incrementally, only the transactions that entered the pool since the last
+// collection are retrieved.
+//
+func TestPoolIncremental(t *testing.T) {
+	server, err := fakemonerod.New(fakemonerod.WithResponse(
+		"/get_transaction_pool_hashes", poolHashes(t, txnF),
+	))
+	if err != nil {
+		t.Fatalf("fakemonerod: %v", err)
+	}
+	t.Cleanup(server.Close)
+
+	c := newServerCollector(t, server,
+		collector.WithCollectors("transaction_pool"),
+		collector.WithIncrementalPool(true),
+	)
+
+	// hashes are the transactions in the pool for each step, with the
+	// first going by those that the server started with.
+	//
+	for _, step := range []struct {
+		desc    string
+		hashes  []string
+		fetches int
+		inPool  float64
+	}{
+		{
+			desc:    "first collection",
+			fetches: 1,
+			inPool:  1,
+		},
+		{
+			desc:    "unchanged",
+			hashes:  []string{txnF},
+			fetches: 1,
+			inPool:  1,
+		},
+		{
+			desc:    "transaction entered",
+			hashes:  []string{txnF, txnE},
+			fetches: 2,
+			inPool:  2,
+		},
+		{
+			desc:    "transaction left",
+			hashes:  []string{txnE},
+			fetches: 2,
+			inPool:  1,
+		},
+	} {
+		if step.hashes != nil {
+			err := server.SetResponse("/get_transaction_pool_hashes",
+				poolHashes(t, step.hashes...))
+			if err != nil {
+				t.Fatalf("set response: %v", err)
+			}
+		}
+
+		families := gather(t, c)
+
+		if n := server.Requests("/get_transactions"); n != step.fetches {
+			t.Errorf("%s: expected %d get_transactions requests "+
+				"so far, got %d", step.desc, step.fetches, n)
+		}
+
+		v, _ := sample(families,
+			"monero_transaction_pool_fees_micronero_per_kb")
+		if v != step.inPool {
+			t.Errorf("%s: expected %v transactions, got %v",
+				step.desc, step.inPool, v)
+		}
+	}
+
+	if n := server.Requests("/get_transaction_pool"); n != 0 {
+		t.Errorf("expected the whole pool not to be retrieved, "+
+			"got %d requests", n)
+	}
+}
@@ -0,0 +1,153 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// reorgTrackerDepth is the number of blocks (counting from the tip) that the
+// reorg tracker remembers, thus, the deepest reorganization that can be
+// measured precisely.
+//
+const reorgTrackerDepth = 100
+
+// chainBlock identifies a block of the main chain as last seen by us.
+//
+type chainBlock struct {
+	height uint64
+	hash   string
+}
+
+// reorgTracker remembers the last blocks of the main chain so that it can
+// tell when they get replaced, i.e., when the chain gets reorganized.
+//
+type reorgTracker struct {
+	depth int
+
+	mu     sync.Mutex
+	recent []chainBlock
+
+	reorgs     prometheus.Counter
+	reorgDepth prometheus.Histogram
+
+	log logr.Logger
+}
+
+func newReorgTracker(depth int, log logr.Logger) *reorgTracker {
+	return &reorgTracker{
+		depth: depth,
+		reorgs: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: metricChainReorgsTotal.Name,
+			Help: metricChainReorgsTotal.Help,
+		}),
+		reorgDepth: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    metricChainReorgDepth.Name,
+			Help:    metricChainReorgDepth.Help,
+			Buckets: []float64{1, 2, 3, 5, 10, 20, 50, 100},
+		}),
+		log: log,
+	}
+}
+
+// Observe looks at the current tip of the chain, accounting for a
+// reorganization if any of the blocks we remember is no longer part of it.
+//
+func (t *reorgTracker) Observe(ctx context.Context, client Client) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	resp, err := client.GetLastBlockHeader(ctx)
+	if err != nil {
+		return fmt.Errorf("get last block header: %w", err)
+	}
+
+	tip := resp.BlockHeader
+
+	if len(t.recent) > 0 {
+		last := t.recent[len(t.recent)-1]
+
+		if tip.Height == last.height && tip.Hash == last.hash {
+			return nil
+		}
+
+		if tip.Height == last.height+1 && tip.PrevHash == last.hash {
+			t.remember(append(t.recent, chainBlock{
+				height: tip.Height,
+				hash:   tip.Hash,
+			}))
+			return nil
+		}
+	}
+
+	// the tip doesn't simply extend what we know of, so compare what we
+	// remember against the chain as it is now.
+	//
+	start := uint64(0)
+	if tip.Height+1 > uint64(t.depth) {
+		start = tip.Height + 1 - uint64(t.depth)
+	}
+
+	headersResp, err := client.GetBlockHeadersRange(ctx, start, tip.Height)
+	if err != nil {
+		return fmt.Errorf("get block headers range: %w", err)
+	}
+
+	current := make([]chainBlock, len(headersResp.Headers))
+	currentByHeight := make(map[uint64]string, len(headersResp.Headers))
+
+	for idx, header := range headersResp.Headers {
+		current[idx] = chainBlock{
+			height: header.Height,
+			hash:   header.Hash,
+		}
+		currentByHeight[header.Height] = header.Hash
+	}
+
+	t.detect(currentByHeight, start, tip.Hash)
+	t.remember(current)
+
+	return nil
+}
+
+// detect accounts for a reorganization in case any of the blocks we remember
+// from height `start` onwards is not part of the `current` chain.
+//
+func (t *reorgTracker) detect(
+	current map[uint64]string, start uint64, tipHash string,
+) {
+	for idx, block := range t.recent {
+		if block.height < start || current[block.height] == block.hash {
+			continue
+		}
+
+		depth := len(t.recent) - idx
+
+		t.log.Info("chain reorganization",
+			"height", block.height,
+			"depth", depth,
+			"old_hash", block.hash,
+			"new_hash", current[block.height],
+			"old_tip", t.recent[len(t.recent)-1].hash,
+			"new_tip", tipHash,
+		)
+
+		t.reorgs.Inc()
+		t.reorgDepth.Observe(float64(depth))
+
+		return
+	}
+}
+
+// remember keeps track of the last `depth` blocks of `blocks`.
+//
+func (t *reorgTracker) remember(blocks []chainBlock) {
+	if len(blocks) > t.depth {
+		blocks = blocks[len(blocks)-t.depth:]
+	}
+
+	t.recent = blocks
+}
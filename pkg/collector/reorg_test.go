@@ -0,0 +1,99 @@
+package collector_test
+
+import (
+	"testing"
+
+	"github.com/cirocosta/monero-exporter/pkg/collector"
+)
+
+// TestReorgs checks how changes to the main chain in between two collections
+// are accounted for as reorganizations.
+//
+func TestReorgs(t *testing.T) {
+	genesis := testBlock{hash: "genesis"}
+	initial := chainOf(genesis, "a", 100, 104)
+
+	// on top of `initial`'s block at `height`, a chain of `b` blocks up to
+	// height `to`.
+	//
+	fork := func(height, to uint64) []testBlock {
+		kept := initial[:height-100+1]
+		return append(append([]testBlock{}, kept...),
+			chainOf(kept[len(kept)-1], "b", height+1, to)...)
+	}
+
+	for _, tc := range []struct {
+		desc   string
+		chain  []testBlock
+		reorgs float64
+		depth  float64
+	}{
+		{
+			desc:  "unchanged",
+			chain: initial,
+		},
+		{
+			desc:  "extended",
+			chain: chainOf(genesis, "a", 100, 106),
+		},
+		{
+			desc:   "tip replaced at the same height",
+			chain:  fork(103, 104),
+			reorgs: 1,
+			depth:  1,
+		},
+		{
+			desc:   "tip replaced by a longer chain",
+			chain:  fork(102, 106),
+			reorgs: 1,
+			depth:  2,
+		},
+		{
+			desc:   "tip going backwards",
+			chain:  fork(101, 103),
+			reorgs: 1,
+			depth:  3,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			server := newTestServer(t)
+			setChain(t, server, initial)
+
+			c := newServerCollector(t, server,
+				collector.WithCollectors("reorgs"),
+			)
+
+			gather(t, c)
+
+			setChain(t, server, tc.chain)
+			families := gather(t, c)
+
+			reorgs, _ := sample(families, "monero_chain_reorgs_total")
+			if reorgs != tc.reorgs {
+				t.Errorf("expected %v reorgs, got %v",
+					tc.reorgs, reorgs)
+			}
+
+			if tc.reorgs == 0 {
+				return
+			}
+
+			// the depth falls in the bucket for it, but not in the
+			// one below.
+			//
+			for le, expected := range map[float64]uint64{
+				tc.depth:     1,
+				tc.depth - 1: 0,
+			} {
+				count, _ := bucket(families,
+					"monero_chain_reorg_depth", le)
+				if count != expected {
+					t.Errorf("expected %d reorgs up to depth "+
+						"%v, got %d", expected, le, count)
+				}
+			}
+		})
+	}
+}
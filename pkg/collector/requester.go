@@ -0,0 +1,151 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/cirocosta/go-monero/pkg/rpc/daemon"
+)
+
+// sharedRequester is a daemon.Requester that performs each distinct request
+// (same method or endpoint, same parameters) only once throughout its
+// lifetime, sharing the response with every caller.
+//
+// It's meant to be short-lived (i.e., live for a single collection) so that
+// collectors that need the same piece of information (e.g., `get_info`) don't
+// end up multiplying the load on monerod.
+//
+type sharedRequester struct {
+	requester daemon.Requester
+
+	calls map[string]*sharedCall
+	mu    sync.Mutex
+}
+
+var _ daemon.Requester = (*sharedRequester)(nil)
+
+// sharedCall is a request that is either in-flight or completed.
+//
+type sharedCall struct {
+	done chan struct{}
+
+	result interface{}
+	err    error
+
+	encodeOnce sync.Once
+	encoded    []byte
+	encodeErr  error
+}
+
+func newSharedRequester(requester daemon.Requester) *sharedRequester {
+	return &sharedRequester{
+		requester: requester,
+		calls:     map[string]*sharedCall{},
+	}
+}
+
+// JSONRPC implements daemon.Requester.
+//
+func (r *sharedRequester) JSONRPC(
+	ctx context.Context, method string, params, result interface{},
+) error {
+	return r.do(ctx, "jsonrpc:"+method, params, result,
+		func(ctx context.Context, result interface{}) error {
+			return r.requester.JSONRPC(ctx, method, params, result)
+		},
+	)
+}
+
+// RawRequest implements daemon.Requester.
+//
+func (r *sharedRequester) RawRequest(
+	ctx context.Context,
+	endpoint string,
+	params interface{},
+	response interface{},
+) error {
+	return r.do(ctx, "raw:"+endpoint, params, response,
+		func(ctx context.Context, response interface{}) error {
+			return r.requester.RawRequest(
+				ctx, endpoint, params, response,
+			)
+		},
+	)
+}
+
+// do either performs the request (if no one did so before) or waits for the
+// one that has been performed to complete, copying its result over.
+//
+func (r *sharedRequester) do(
+	ctx context.Context,
+	name string,
+	params interface{},
+	result interface{},
+	fn func(ctx context.Context, result interface{}) error,
+) error {
+	encodedParams, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("marshal params: %w", err)
+	}
+
+	key := name + ":" + string(encodedParams)
+
+	r.mu.Lock()
+	call, found := r.calls[key]
+	if !found {
+		call = &sharedCall{done: make(chan struct{})}
+		r.calls[key] = call
+	}
+	r.mu.Unlock()
+
+	if !found {
+		call.err = fn(ctx, result)
+		call.result = result
+
+		if call.err != nil {
+			// let those coming after us try again rather than
+			// failing due to our (possibly context-specific)
+			// failure.
+			//
+			r.mu.Lock()
+			delete(r.calls, key)
+			r.mu.Unlock()
+		}
+
+		close(call.done)
+
+		return call.err
+	}
+
+	select {
+	case <-call.done:
+	case <-ctx.Done():
+		return fmt.Errorf("ctx err: %w", ctx.Err())
+	}
+
+	if call.err != nil {
+		return fmt.Errorf("shared call: %w", call.err)
+	}
+
+	return call.copyTo(result)
+}
+
+// copyTo fills `dst` with the result of the call.
+//
+func (c *sharedCall) copyTo(dst interface{}) error {
+	c.encodeOnce.Do(func() {
+		c.encoded, c.encodeErr = json.Marshal(c.result)
+	})
+
+	if c.encodeErr != nil {
+		return fmt.Errorf("marshal result: %w", c.encodeErr)
+	}
+
+	if err := json.Unmarshal(c.encoded, dst); err != nil {
+		return fmt.Errorf("unmarshal result: %w", err)
+	}
+
+	return nil
+}
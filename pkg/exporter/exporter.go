@@ -5,25 +5,70 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/zapr"
-	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
+
+	"github.com/cirocosta/monero-exporter/pkg/collector"
 )
 
 const (
 	defaultBindAddress   = ":9000"
 	defaultTelemetryPath = "/metrics"
+	defaultProbePath     = "/probe"
+
+	defaultScrapeTimeoutOffset = 500 * time.Millisecond
 )
 
 // Exporter is responsible for bringing up a web server that collects metrics
-// that have been globally registered via prometheus collectors (e.g., see
-// `pkg/collector`).
+// that have been registered via prometheus collectors (e.g., see
+// `pkg/collector`), either globally or to a registry of its own (see
+// `WithRegistry`).
 //
 type Exporter struct {
 	bindAddress   string
 	telemetryPath string
+	probePath     string
+
+	// probeTargets is the set of monerod addresses that are allowed to be
+	// probed via `probePath`.
+	//
+	// optional: if empty, the probe endpoint is not served.
+	//
+	probeTargets map[string]struct{}
+
+	// collectorOpts are the options passed to the collectors instantiated
+	// for each probed target.
+	//
+	collectorOpts []collector.Option
+
+	// probeCollectors are the collectors instantiated for each of the
+	// targets probed so far, kept across probes so that what collectors
+	// keep track of (e.g., the block window) isn't lost in between.
+	//
+	probeCollectorsMu sync.Mutex
+	probeCollectors   map[string]*collector.Collector
+
+	// registry is the gatherer that scrapes are served from.
+	//
+	registry prometheus.Gatherer
+
+	// gathererFunc provides an extra gatherer to serve each scrape
+	// from.
+	//
+	// optional: if nil, scrapes are served exclusively from `registry`.
+	//
+	gathererFunc GathererFunc
+
+	// scrapeTimeoutOffset is the amount of time subtracted from the
+	// scrape timeout advertised by prometheus to leave room for writing
+	// the response.
+	//
+	scrapeTimeoutOffset time.Duration
 
 	listener net.Listener
 	log      logr.Logger
@@ -56,6 +101,69 @@ func WithBindAddress(v string) Option {
 	}
 }
 
+// WithProbePath overrides the default path under which targets can be probed
+// (`<path>?target=<monerod address>`).
+//
+func WithProbePath(v string) Option {
+	return func(e *Exporter) {
+		e.probePath = v
+	}
+}
+
+// WithProbeTargets enables the probe endpoint, allowing the monerod addresses
+// provided to be probed.
+//
+// For instance:
+//   - http://localhost:18081
+//   - http://node.example.com:18089
+//
+func WithProbeTargets(v ...string) Option {
+	return func(e *Exporter) {
+		for _, target := range v {
+			e.probeTargets[target] = struct{}{}
+		}
+	}
+}
+
+// WithCollectorOptions overrides the default options used for instantiating
+// the collector that serves the probes of each target.
+//
+func WithCollectorOptions(v ...collector.Option) Option {
+	return func(e *Exporter) {
+		e.collectorOpts = v
+	}
+}
+
+// WithRegistry overrides the default registry (the global one) that scrapes
+// are served from.
+//
+func WithRegistry(v prometheus.Gatherer) Option {
+	return func(e *Exporter) {
+		e.registry = v
+	}
+}
+
+// WithGathererFunc provides a gatherer to serve each scrape from, in addition
+// to the registry, allowing the collection to be bound to each scrape's
+// context (thus, honoring prometheus' scrape timeout).
+//
+func WithGathererFunc(v GathererFunc) Option {
+	return func(e *Exporter) {
+		e.gathererFunc = v
+	}
+}
+
+// WithScrapeTimeoutOffset overrides the default amount of time subtracted from
+// the scrape timeout advertised by prometheus
+// (`X-Prometheus-Scrape-Timeout-Seconds`) when deciding for how long to
+// collect.
+//
+func WithScrapeTimeoutOffset(v time.Duration) Option {
+	return func(e *Exporter) {
+		e.scrapeTimeoutOffset = v
+	}
+}
+
 // Option allows overriding the exporter's defaults
 //
 type Option func(e *Exporter)
@@ -71,7 +179,13 @@ func New(opts ...Option) (*Exporter, error) {
 	e := &Exporter{
 		bindAddress:   defaultBindAddress,
 		telemetryPath: defaultTelemetryPath,
+		probePath:     defaultProbePath,
+		probeTargets:  map[string]struct{}{},
+		registry:      prometheus.DefaultGatherer,
 		log:           zapr.NewLogger(defaultLogger.Named("exporter")),
+
+		probeCollectors:     map[string]*collector.Collector{},
+		scrapeTimeoutOffset: defaultScrapeTimeoutOffset,
 	}
 
 	for _, opt := range opts {
@@ -104,8 +218,14 @@ func (e *Exporter) Run(ctx context.Context) error {
 			"path", e.telemetryPath,
 		).Info("listening")
 
-		http.Handle(e.telemetryPath, promhttp.Handler())
-		if err := http.Serve(e.listener, nil); err != nil {
+		mux := http.NewServeMux()
+
+		mux.HandleFunc(e.telemetryPath, e.metricsHandler)
+		if len(e.probeTargets) > 0 {
+			mux.HandleFunc(e.probePath, e.probeHandler)
+		}
+
+		if err := http.Serve(e.listener, mux); err != nil {
 			doneChan <- fmt.Errorf(
 				"failed listening on address %s: %w",
 				e.bindAddress, err,
@@ -0,0 +1,103 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/cirocosta/go-monero/pkg/rpc"
+	"github.com/cirocosta/go-monero/pkg/rpc/daemon"
+	"github.com/cirocosta/monero-exporter/pkg/collector"
+)
+
+// probeHandler serves the metrics of a single monerod target, supplied via
+// the `target` query parameter, in the same fashion as prometheus'
+// blackbox_exporter does.
+//
+// The collector for the target is registered with a registry of its own for
+// each request, so that only the metrics for that target are served.
+//
+func (e *Exporter) probeHandler(w http.ResponseWriter, r *http.Request) {
+	target := r.URL.Query().Get("target")
+	if target == "" {
+		http.Error(w, "'target' parameter must be specified",
+			http.StatusBadRequest)
+		return
+	}
+
+	if _, allowed := e.probeTargets[target]; !allowed {
+		http.Error(w, fmt.Sprintf("target '%s' not allowed", target),
+			http.StatusForbidden)
+		return
+	}
+
+	ctx, cancel := e.scrapeContext(r)
+	defer cancel()
+
+	registry, err := e.probeRegistry(ctx, target)
+	if err != nil {
+		e.log.Error(err, "probe", "target", target)
+		http.Error(w, fmt.Sprintf("probe '%s': %v", target, err),
+			http.StatusInternalServerError)
+		return
+	}
+
+	promhttp.HandlerFor(registry, promhttp.HandlerOpts{}).ServeHTTP(w, r)
+}
+
+// probeRegistry instantiates a registry with the collector targetting the
+// monerod instance at `target` registered to it, having its collection bound
+// to `ctx`.
+//
+func (e *Exporter) probeRegistry(
+	ctx context.Context, target string,
+) (*prometheus.Registry, error) {
+	c, err := e.probeCollector(target)
+	if err != nil {
+		return nil, fmt.Errorf("probe collector: %w", err)
+	}
+
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(c.WithContext(ctx)); err != nil {
+		return nil, fmt.Errorf("register: %w", err)
+	}
+
+	return registry, nil
+}
+
+// probeCollector retrieves the collector targetting the monerod instance at
+// `target`, instantiating it on the first probe.
+//
+// Collectors used to be instantiated on every probe, but some of them keep
+// state across collections (the block window, reorgs, emission and
+// transaction inclusion ones), which a fresh collector would have to build
+// up from scratch each time, thus why they're kept per target.
+//
+// ps.: collectors are kept for as long as the exporter lives - as only
+// allowed targets can be probed, there's no more of them than those.
+//
+func (e *Exporter) probeCollector(target string) (*collector.Collector, error) {
+	e.probeCollectorsMu.Lock()
+	defer e.probeCollectorsMu.Unlock()
+
+	if c, found := e.probeCollectors[target]; found {
+		return c, nil
+	}
+
+	rpcClient, err := rpc.NewClient(target)
+	if err != nil {
+		return nil, fmt.Errorf("new client '%s': %w", target, err)
+	}
+
+	c, err := collector.New(daemon.NewClient(rpcClient), e.collectorOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("new collector: %w", err)
+	}
+
+	e.probeCollectors[target] = c
+
+	return c, nil
+}
@@ -0,0 +1,73 @@
+package exporter
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// scrapeTimeoutHeader is the header through which prometheus lets targets
+// know how long it's willing to wait for a scrape to complete.
+//
+const scrapeTimeoutHeader = "X-Prometheus-Scrape-Timeout-Seconds"
+
+// GathererFunc provides a gatherer that serves a single scrape, having its
+// collection bound to `ctx` (see `collector.Collector.WithContext`).
+//
+type GathererFunc func(ctx context.Context) (prometheus.Gatherer, error)
+
+// scrapeContext derives from the request a context that is done once the
+// scrape timeout advertised by prometheus (minus an offset that accounts for
+// the time needed to write the response back) is reached.
+//
+// ps.: if no timeout is advertised, the request's context is used as is.
+//
+func (e *Exporter) scrapeContext(
+	r *http.Request,
+) (context.Context, context.CancelFunc) {
+	v := r.Header.Get(scrapeTimeoutHeader)
+	if v == "" {
+		return context.WithCancel(r.Context())
+	}
+
+	seconds, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		e.log.Error(err, "parse scrape timeout", "value", v)
+		return context.WithCancel(r.Context())
+	}
+
+	timeout := time.Duration(seconds * float64(time.Second))
+	if timeout > e.scrapeTimeoutOffset {
+		timeout -= e.scrapeTimeoutOffset
+	}
+
+	return context.WithTimeout(r.Context(), timeout)
+}
+
+// metricsHandler serves the metrics provided by the registry, along with
+// those from the gatherer provided for the scrape (if any).
+//
+func (e *Exporter) metricsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := e.scrapeContext(r)
+	defer cancel()
+
+	gatherers := prometheus.Gatherers{e.registry}
+
+	if e.gathererFunc != nil {
+		gatherer, err := e.gathererFunc(ctx)
+		if err != nil {
+			e.log.Error(err, "gatherer")
+			http.Error(w, "failed to gather metrics: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		gatherers = append(gatherers, gatherer)
+	}
+
+	promhttp.HandlerFor(gatherers, promhttp.HandlerOpts{}).ServeHTTP(w, r)
+}
@@ -0,0 +1,14 @@
+// Package fakemonerod provides an in-process fake of monero's daemon RPC,
+// serving JSON fixtures (embedded, or overridden per method/endpoint) through
+// an `httptest` server.
+//
+// It's meant for exercising code that talks to a node (e.g., the collectors in
+// `pkg/collector`) without one:
+//
+//	server, _ := fakemonerod.New()
+//	defer server.Close()
+//
+//	client, _ := rpc.NewClient(server.URL)
+//	c, _ := collector.New(daemon.NewClient(client))
+//
+package fakemonerod
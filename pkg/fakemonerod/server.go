@@ -0,0 +1,296 @@
+package fakemonerod
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"sync"
+)
+
+// endpointJSONRPC is the endpoint through which monerod serves its JSON-RPC
+// methods.
+//
+const endpointJSONRPC = "/json_rpc"
+
+// endpointGetTransactions is the endpoint through which monerod serves the
+// transactions asked for by hash.
+//
+const endpointGetTransactions = "/get_transactions"
+
+// fixtures holds the default responses served by the fake: the `result` of
+// JSON-RPC methods under `jsonrpc/<method>.json`, and the full body of other
+// endpoints under `<endpoint>.json`.
+//
+//go:embed fixtures
+var fixtures embed.FS
+
+// Server is an in-process fake monerod that serves canned responses over
+// HTTP, so that anything that talks to a node (e.g., `pkg/collector`) can be
+// exercised without one.
+//
+// Responses are keyed by the name of the JSON-RPC method they answer (e.g.,
+// `get_info`) or by the endpoint that serves them (e.g., `/get_peer_list`).
+//
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	responses map[string]json.RawMessage
+	requests  map[string]int
+}
+
+// Option configures a Server at construction time.
+//
+type Option func(s *Server)
+
+// WithResponse overrides (or adds) the raw JSON response served for a
+// JSON-RPC method or endpoint (see `Server.SetResponse`).
+//
+func WithResponse(name string, body []byte) Option {
+	return func(s *Server) {
+		s.responses[name] = body
+	}
+}
+
+// New starts a fake monerod serving the embedded fixtures, unless options
+// are passed. It's up to the caller to `Close` it once done.
+//
+func New(opts ...Option) (*Server, error) {
+	s := &Server{
+		responses: map[string]json.RawMessage{},
+		requests:  map[string]int{},
+	}
+
+	if err := s.loadFixtures(); err != nil {
+		return nil, fmt.Errorf("load fixtures: %w", err)
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+
+	return s, nil
+}
+
+// SetResponse replaces the response served for a JSON-RPC method (e.g.,
+// `get_info`) or endpoint (e.g., `/get_peer_list`). `v` is encoded as JSON
+// unless it's already a `[]byte` or `json.RawMessage`.
+//
+// ps.: for JSON-RPC methods, `v` is the `result` only - the envelope is
+// added by the server.
+//
+func (s *Server) SetResponse(name string, v interface{}) error {
+	var body json.RawMessage
+
+	switch vv := v.(type) {
+	case json.RawMessage:
+		body = vv
+	case []byte:
+		body = vv
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("marshal: %w", err)
+		}
+
+		body = b
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.responses[name] = body
+
+	return nil
+}
+
+// RemoveResponse stops serving a JSON-RPC method or endpoint, which then
+// fails as it would on a node that doesn't serve it (e.g., restricted RPC
+// ports).
+//
+func (s *Server) RemoveResponse(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.responses, name)
+}
+
+// Requests retrieves how many times a JSON-RPC method or endpoint has been
+// requested.
+//
+func (s *Server) Requests(name string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests[name]
+}
+
+// loadFixtures loads every embedded fixture as the default response for the
+// method or endpoint it's named after.
+//
+func (s *Server) loadFixtures() error {
+	return fs.WalkDir(fixtures, "fixtures", func(
+		p string, d fs.DirEntry, err error,
+	) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || path.Ext(p) != ".json" {
+			return nil
+		}
+
+		body, err := fixtures.ReadFile(p)
+		if err != nil {
+			return fmt.Errorf("read file '%s': %w", p, err)
+		}
+
+		dir, file := path.Split(strings.TrimPrefix(p, "fixtures/"))
+		name := strings.TrimSuffix(file, ".json")
+
+		if dir != "jsonrpc/" {
+			name = "/" + name
+		}
+
+		s.responses[name] = body
+		return nil
+	})
+}
+
+// response retrieves the response for a method or endpoint, accounting for
+// the request.
+//
+func (s *Server) response(name string) (json.RawMessage, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests[name]++
+
+	body, found := s.responses[name]
+	return body, found
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == endpointJSONRPC {
+		s.handleJSONRPC(w, r)
+		return
+	}
+
+	body, found := s.response(r.URL.Path)
+	if !found {
+		http.NotFound(w, r)
+		return
+	}
+
+	if r.URL.Path == endpointGetTransactions {
+		filtered, err := filterTransactions(body, r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		body = filtered
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(body)
+}
+
+// handleJSONRPC serves the result for the method requested, wrapped in a
+// JSON-RPC response envelope.
+//
+func (s *Server) handleJSONRPC(w http.ResponseWriter, r *http.Request) {
+	req := struct {
+		ID     string `json:"id"`
+		Method string `json:"method"`
+	}{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "decode: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	type rpcError struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+
+	resp := struct {
+		ID      string          `json:"id"`
+		JSONRPC string          `json:"jsonrpc"`
+		Result  json.RawMessage `json:"result,omitempty"`
+		Error   *rpcError       `json:"error,omitempty"`
+	}{
+		ID:      req.ID,
+		JSONRPC: "2.0",
+	}
+
+	body, found := s.response(req.Method)
+	if found {
+		resp.Result = body
+	} else {
+		resp.Error = &rpcError{Code: -32601, Message: "Method not found"}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// filterTransactions narrows the transactions (`txs`) in a `get_transactions`
+// response down to those whose hashes were asked for, like monerod would.
+//
+func filterTransactions(body []byte, r *http.Request) ([]byte, error) {
+	req := struct {
+		TxsHashes []string `json:"txs_hashes"`
+	}{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("decode: %w", err)
+	}
+
+	resp := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	txs := []json.RawMessage{}
+	if err := json.Unmarshal(resp["txs"], &txs); err != nil {
+		return nil, fmt.Errorf("unmarshal txs: %w", err)
+	}
+
+	wanted := make(map[string]struct{}, len(req.TxsHashes))
+	for _, hash := range req.TxsHashes {
+		wanted[hash] = struct{}{}
+	}
+
+	filtered := []json.RawMessage{}
+	for _, tx := range txs {
+		txHash := struct {
+			TxHash string `json:"tx_hash"`
+		}{}
+
+		if err := json.Unmarshal(tx, &txHash); err != nil {
+			return nil, fmt.Errorf("unmarshal tx: %w", err)
+		}
+
+		if _, found := wanted[txHash.TxHash]; found {
+			filtered = append(filtered, tx)
+		}
+	}
+
+	b, err := json.Marshal(filtered)
+	if err != nil {
+		return nil, fmt.Errorf("marshal txs: %w", err)
+	}
+
+	resp["txs"] = b
+
+	return json.Marshal(resp)
+}